@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"iter"
 	"net/http"
 	"time"
 )
@@ -32,6 +33,155 @@ type AppsServiceAPI interface {
 	//
 	// See: https://docs.snyk.io/snyk-api/reference/apps#delete-orgs-org_id-apps-installs-install_id
 	DeleteAppInstallFromOrg(ctx context.Context, orgID, appInstallID string) (*Response, error)
+
+	// AllAppInstallsForOrg returns an iterator to paginate over all Snyk Apps installed for an Organization.
+	//
+	// This method handles the pagination logic internally by calling ListAppInstallsForOrg for each page.
+	// The return iterated can be used in a for...range loop to easily process all app installs.
+	//
+	// Note: This function is experimental and its signature may change in a future release.
+	AllAppInstallsForOrg(ctx context.Context, orgID string, opts *ListAppInstallOptions) (iter.Seq2[AppInstall, *Response], func() error)
+
+	// ManageAppInstallSecretsForOrg creates, replaces or deletes the client secrets of a Snyk App installed for
+	// an Organization. A new client secret is returned in AppInstallAttributes.ClientSecret.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/apps#post-orgs-org_id-apps-installs-install_id-secrets
+	ManageAppInstallSecretsForOrg(ctx context.Context, orgID, appInstallID string, mode AppSecretMode, secret string) (*AppInstall, *Response, error)
+
+	// ListAppInstallsForGroup gets a list of Snyk Apps installed for a Group. If ListAppInstallOptions is nil,
+	// then relationship for App will be always expanded.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/apps#get-groups-group_id-apps-installs
+	ListAppInstallsForGroup(ctx context.Context, groupID string, opts *ListAppInstallOptions) ([]AppInstall, *Response, error)
+
+	// AllAppInstallsForGroup returns an iterator to paginate over all Snyk Apps installed for a Group.
+	//
+	// This method handles the pagination logic internally by calling ListAppInstallsForGroup for each page.
+	// The return iterated can be used in a for...range loop to easily process all app installs.
+	//
+	// Note: This function is experimental and its signature may change in a future release.
+	AllAppInstallsForGroup(ctx context.Context, groupID string, opts *ListAppInstallOptions) (iter.Seq2[AppInstall, *Response], func() error)
+
+	// CreateAppInstallForGroup installs a Snyk App to a Group. The App must use unattended authentication e.g. client credentials.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/apps#post-groups-group_id-apps-installs
+	CreateAppInstallForGroup(ctx context.Context, groupID, appID string) (*AppInstall, *Response, error)
+
+	// DeleteAppInstallFromGroup revokes app authorization for a Group with install ID.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/apps#delete-groups-group_id-apps-installs-install_id
+	DeleteAppInstallFromGroup(ctx context.Context, groupID, appInstallID string) (*Response, error)
+
+	// ManageAppInstallSecretsForGroup creates, replaces or deletes the client secrets of a Snyk App installed for
+	// a Group. A new client secret is returned in AppInstallAttributes.ClientSecret.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/apps#post-groups-group_id-apps-installs-install_id-secrets
+	ManageAppInstallSecretsForGroup(ctx context.Context, groupID, appInstallID string, mode AppSecretMode, secret string) (*AppInstall, *Response, error)
+
+	// ListAppInstallsForUser gets a list of Snyk Apps the current user has authorized to act on their behalf.
+	// If ListAppInstallOptions is nil, then relationship for App will be always expanded.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/apps#get-self-apps-installs
+	ListAppInstallsForUser(ctx context.Context, opts *ListAppInstallOptions) ([]AppInstall, *Response, error)
+
+	// AllAppInstallsForUser returns an iterator to paginate over all Snyk Apps the current user has authorized.
+	//
+	// This method handles the pagination logic internally by calling ListAppInstallsForUser for each page.
+	// The return iterated can be used in a for...range loop to easily process all app installs.
+	//
+	// Note: This function is experimental and its signature may change in a future release.
+	AllAppInstallsForUser(ctx context.Context, opts *ListAppInstallOptions) (iter.Seq2[AppInstall, *Response], func() error)
+
+	// DeleteAppInstallFromUser revokes the authorization the current user granted to a Snyk App.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/apps#delete-self-apps-installs-install_id
+	DeleteAppInstallFromUser(ctx context.Context, appInstallID string) (*Response, error)
+
+	// ListAppsForOrg gets a paginated list of Snyk Apps created by an Organization.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/apps#get-orgs-org_id-apps-creations
+	ListAppsForOrg(ctx context.Context, orgID string, opts *ListOptions) ([]App, *Response, error)
+
+	// AllAppsForOrg returns an iterator to paginate over all Snyk Apps created by an Organization.
+	//
+	// This method handles the pagination logic internally by calling ListAppsForOrg for each page.
+	// The return iterated can be used in a for...range loop to easily process all apps.
+	//
+	// Note: This function is experimental and its signature may change in a future release.
+	AllAppsForOrg(ctx context.Context, orgID string, opts *ListOptions) (iter.Seq2[App, *Response], func() error)
+
+	// GetAppForOrg provides the details of a Snyk App created by an Organization.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/apps#get-orgs-org_id-apps-creations-app_id
+	GetAppForOrg(ctx context.Context, orgID, appID string) (*App, *Response, error)
+
+	// CreateAppForOrg creates a new Snyk App owned by an Organization. The client secret of the
+	// App is only returned by this call, see AppAttributes.ClientSecret.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/apps#post-orgs-org_id-apps-creations
+	CreateAppForOrg(ctx context.Context, orgID string, createRequest *AppCreateRequest) (*App, *Response, error)
+
+	// UpdateAppForOrg changes the details of a Snyk App created by an Organization.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/apps#patch-orgs-org_id-apps-creations-app_id
+	UpdateAppForOrg(ctx context.Context, orgID, appID string, updateRequest *AppUpdateRequest) (*App, *Response, error)
+
+	// DeleteAppFromOrg removes a Snyk App created by an Organization, all its installs are revoked.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/apps#delete-orgs-org_id-apps-creations-app_id
+	DeleteAppFromOrg(ctx context.Context, orgID, appID string) (*Response, error)
+
+	// ManageAppSecretsForOrg creates, replaces or deletes the client secrets of a Snyk App created
+	// by an Organization. A new client secret is returned in AppAttributes.ClientSecret.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/apps#post-orgs-org_id-apps-creations-app_id-secrets
+	ManageAppSecretsForOrg(ctx context.Context, orgID, appID string, mode AppSecretMode, secret string) (*App, *Response, error)
+
+	// ListAppsForGroup gets a paginated list of Snyk Apps created by a Group.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/apps#get-groups-group_id-apps-creations
+	ListAppsForGroup(ctx context.Context, groupID string, opts *ListOptions) ([]App, *Response, error)
+
+	// AllAppsForGroup returns an iterator to paginate over all Snyk Apps created by a Group.
+	//
+	// This method handles the pagination logic internally by calling ListAppsForGroup for each page.
+	// The return iterated can be used in a for...range loop to easily process all apps.
+	//
+	// Note: This function is experimental and its signature may change in a future release.
+	AllAppsForGroup(ctx context.Context, groupID string, opts *ListOptions) (iter.Seq2[App, *Response], func() error)
+
+	// GetAppForGroup provides the details of a Snyk App created by a Group.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/apps#get-groups-group_id-apps-creations-app_id
+	GetAppForGroup(ctx context.Context, groupID, appID string) (*App, *Response, error)
+
+	// CreateAppForGroup creates a new Snyk App owned by a Group. The client secret of the
+	// App is only returned by this call, see AppAttributes.ClientSecret.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/apps#post-groups-group_id-apps-creations
+	CreateAppForGroup(ctx context.Context, groupID string, createRequest *AppCreateRequest) (*App, *Response, error)
+
+	// UpdateAppForGroup changes the details of a Snyk App created by a Group.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/apps#patch-groups-group_id-apps-creations-app_id
+	UpdateAppForGroup(ctx context.Context, groupID, appID string, updateRequest *AppUpdateRequest) (*App, *Response, error)
+
+	// DeleteAppFromGroup removes a Snyk App created by a Group, all its installs are revoked.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/apps#delete-groups-group_id-apps-creations-app_id
+	DeleteAppFromGroup(ctx context.Context, groupID, appID string) (*Response, error)
+
+	// ManageAppSecretsForGroup creates, replaces or deletes the client secrets of a Snyk App created
+	// by a Group. A new client secret is returned in AppAttributes.ClientSecret.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/apps#post-groups-group_id-apps-creations-app_id-secrets
+	ManageAppSecretsForGroup(ctx context.Context, groupID, appID string, mode AppSecretMode, secret string) (*App, *Response, error)
+
+	// ListAppBotsForOrg gets a paginated list of app bots, the users acting on behalf of Snyk Apps
+	// authorized for an Organization.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/apps#get-orgs-org_id-app_bots
+	ListAppBotsForOrg(ctx context.Context, orgID string, opts *ListOptions) ([]AppBot, *Response, error)
 }
 
 // AppsService handles communication with the app related methods of the Snyk API.
@@ -49,10 +199,66 @@ type App struct {
 }
 
 type AppAttributes struct {
-	ClientID string   `json:"client_id"`         // The oauth2 client id for the app.
-	Context  string   `json:"context,omitempty"` // Allow installing the app to at org/group level or user level. Defaults to tenant.
-	Name     string   `json:"name"`              // The name of the app.
-	Scopes   []string `json:"scopes,omitempty"`  // The scopes this app is allowed to request during authorization.
+	AccessTokenTTLSeconds int      `json:"access_token_ttl_seconds,omitempty"` // The lifetime of access tokens issued to the app.
+	ClientID              string   `json:"client_id"`                          // The oauth2 client id for the app.
+	ClientSecret          string   `json:"client_secret,omitempty"`            // The oauth2 client secret, only available on creation and secret rotation.
+	Context               string   `json:"context,omitempty"`                  // Allow installing the app to at org/group level or user level. Defaults to tenant.
+	IsPublic              bool     `json:"is_public,omitempty"`                // Whether the app is publicly available.
+	Name                  string   `json:"name"`                               // The name of the app.
+	RedirectURIs          []string `json:"redirect_uris,omitempty"`            // The allowed redirect URIs of the authorization flow.
+	Scopes                []string `json:"scopes,omitempty"`                   // The scopes this app is allowed to request during authorization.
+}
+
+// AppContext defines at which level a Snyk App can be installed.
+type AppContext string
+
+const (
+	AppContextTenant AppContext = "tenant" // The app is installed to an Organization or a Group.
+	AppContextUser   AppContext = "user"   // The app acts on behalf of the authorizing user.
+)
+
+// AppSecretMode defines how the client secrets of a Snyk App are managed.
+type AppSecretMode string
+
+const (
+	AppSecretModeCreate  AppSecretMode = "create"  // Create an additional secret, at most two secrets can exist.
+	AppSecretModeDelete  AppSecretMode = "delete"  // Delete the given secret.
+	AppSecretModeReplace AppSecretMode = "replace" // Replace all existing secrets with a new one.
+)
+
+type AppCreateRequest struct {
+	AccessTokenTTLSeconds int        // The lifetime of access tokens, the Snyk default if not set.
+	Context               AppContext // The install level of the app, tenant if not set.
+	Name                  string
+	RedirectURIs          []string
+	Scopes                []string
+}
+
+type AppUpdateRequest struct {
+	AccessTokenTTLSeconds int      // If set, the new lifetime of access tokens.
+	Name                  string   // If set, the new name.
+	RedirectURIs          []string // If set, the new redirect URIs.
+}
+
+// AppBot represents the user acting on behalf of a Snyk App in an Organization.
+type AppBot struct {
+	ID            string               `json:"id"`                      // The AppBot identifier.
+	Type          string               `json:"type"`                    // The resource type `app_bot`.
+	Relationships *AppBotRelationships `json:"relationships,omitempty"` // The relationships object describing relationships between AppBot and App.
+}
+
+type AppBotRelationships struct {
+	App appRoot `json:"app"`
+}
+
+type appsRoot struct {
+	Apps  []App           `json:"data"`
+	Links *PaginatedLinks `json:"links,omitempty"`
+}
+
+type appBotsRoot struct {
+	AppBots []AppBot        `json:"data"`
+	Links   *PaginatedLinks `json:"links,omitempty"`
 }
 
 type appRoot struct {
@@ -95,17 +301,516 @@ type appInstallsRoot struct {
 
 func (ai AppInstall) String() string { return Stringify(ai) }
 
+func (a App) String() string { return Stringify(a) }
+
 func (s *AppsService) ListAppInstallsForOrg(ctx context.Context, orgID string, opts *ListAppInstallOptions) ([]AppInstall, *Response, error) {
 	if orgID == "" {
 		return nil, nil, errors.New("failed to list app installs for org: org id must be supplied")
 	}
 
+	return s.listAppInstalls(ctx, fmt.Sprintf("%v/%v", orgsBasePath, orgID), opts)
+}
+
+func (s *AppsService) AllAppInstallsForOrg(ctx context.Context, orgID string, opts *ListAppInstallOptions) (iter.Seq2[AppInstall, *Response], func() error) {
+	if orgID == "" {
+		return errorPaginator[AppInstall](errors.New("failed to list app installs for org: org id must be supplied"))
+	}
+
+	return s.allAppInstalls(ctx, fmt.Sprintf("%v/%v", orgsBasePath, orgID), opts)
+}
+
+func (s *AppsService) CreateAppInstallForOrg(ctx context.Context, orgID, appID string) (*AppInstall, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to create app install for org: org id must be supplied")
+	}
+	if appID == "" {
+		return nil, nil, errors.New("failed to create app install for org: app id must be supplied")
+	}
+
+	return s.createAppInstall(ctx, fmt.Sprintf("%v/%v", orgsBasePath, orgID), appID)
+}
+
+func (s *AppsService) DeleteAppInstallFromOrg(ctx context.Context, orgID, appInstallID string) (*Response, error) {
+	if orgID == "" {
+		return nil, errors.New("failed to delete app install for org: org id must be supplied")
+	}
+	if appInstallID == "" {
+		return nil, errors.New("failed to delete app install for org: app install id must be supplied")
+	}
+
+	return s.deleteAppInstall(ctx, fmt.Sprintf("%v/%v", orgsBasePath, orgID), appInstallID)
+}
+
+func (s *AppsService) ManageAppInstallSecretsForOrg(ctx context.Context, orgID, appInstallID string, mode AppSecretMode, secret string) (*AppInstall, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to manage app install secrets for org: org id must be supplied")
+	}
+	if appInstallID == "" {
+		return nil, nil, errors.New("failed to manage app install secrets for org: app install id must be supplied")
+	}
+	if mode == AppSecretModeDelete && secret == "" {
+		return nil, nil, errors.New("failed to manage app install secrets for org: secret must be supplied")
+	}
+
+	return s.manageAppInstallSecrets(ctx, fmt.Sprintf("%v/%v", orgsBasePath, orgID), appInstallID, mode, secret)
+}
+
+func (s *AppsService) ListAppInstallsForGroup(ctx context.Context, groupID string, opts *ListAppInstallOptions) ([]AppInstall, *Response, error) {
+	if groupID == "" {
+		return nil, nil, errors.New("failed to list app installs for group: group id must be supplied")
+	}
+
+	return s.listAppInstalls(ctx, fmt.Sprintf("%v/%v", groupsBasePath, groupID), opts)
+}
+
+func (s *AppsService) AllAppInstallsForGroup(ctx context.Context, groupID string, opts *ListAppInstallOptions) (iter.Seq2[AppInstall, *Response], func() error) {
+	if groupID == "" {
+		return errorPaginator[AppInstall](errors.New("failed to list app installs for group: group id must be supplied"))
+	}
+
+	return s.allAppInstalls(ctx, fmt.Sprintf("%v/%v", groupsBasePath, groupID), opts)
+}
+
+func (s *AppsService) CreateAppInstallForGroup(ctx context.Context, groupID, appID string) (*AppInstall, *Response, error) {
+	if groupID == "" {
+		return nil, nil, errors.New("failed to create app install for group: group id must be supplied")
+	}
+	if appID == "" {
+		return nil, nil, errors.New("failed to create app install for group: app id must be supplied")
+	}
+
+	return s.createAppInstall(ctx, fmt.Sprintf("%v/%v", groupsBasePath, groupID), appID)
+}
+
+func (s *AppsService) DeleteAppInstallFromGroup(ctx context.Context, groupID, appInstallID string) (*Response, error) {
+	if groupID == "" {
+		return nil, errors.New("failed to delete app install for group: group id must be supplied")
+	}
+	if appInstallID == "" {
+		return nil, errors.New("failed to delete app install for group: app install id must be supplied")
+	}
+
+	return s.deleteAppInstall(ctx, fmt.Sprintf("%v/%v", groupsBasePath, groupID), appInstallID)
+}
+
+func (s *AppsService) ManageAppInstallSecretsForGroup(ctx context.Context, groupID, appInstallID string, mode AppSecretMode, secret string) (*AppInstall, *Response, error) {
+	if groupID == "" {
+		return nil, nil, errors.New("failed to manage app install secrets for group: group id must be supplied")
+	}
+	if appInstallID == "" {
+		return nil, nil, errors.New("failed to manage app install secrets for group: app install id must be supplied")
+	}
+	if mode == AppSecretModeDelete && secret == "" {
+		return nil, nil, errors.New("failed to manage app install secrets for group: secret must be supplied")
+	}
+
+	return s.manageAppInstallSecrets(ctx, fmt.Sprintf("%v/%v", groupsBasePath, groupID), appInstallID, mode, secret)
+}
+
+func (s *AppsService) ListAppInstallsForUser(ctx context.Context, opts *ListAppInstallOptions) ([]AppInstall, *Response, error) {
+	return s.listAppInstalls(ctx, "self", opts)
+}
+
+func (s *AppsService) AllAppInstallsForUser(ctx context.Context, opts *ListAppInstallOptions) (iter.Seq2[AppInstall, *Response], func() error) {
+	return s.allAppInstalls(ctx, "self", opts)
+}
+
+func (s *AppsService) DeleteAppInstallFromUser(ctx context.Context, appInstallID string) (*Response, error) {
+	if appInstallID == "" {
+		return nil, errors.New("failed to delete app install for user: app install id must be supplied")
+	}
+
+	return s.deleteAppInstall(ctx, "self", appInstallID)
+}
+
+func (s *AppsService) ListAppsForOrg(ctx context.Context, orgID string, opts *ListOptions) ([]App, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to list apps for org: org id must be supplied")
+	}
+
+	return s.listApps(ctx, fmt.Sprintf("%v/%v", orgsBasePath, orgID), opts)
+}
+
+func (s *AppsService) AllAppsForOrg(ctx context.Context, orgID string, opts *ListOptions) (iter.Seq2[App, *Response], func() error) {
+	if orgID == "" {
+		return errorPaginator[App](errors.New("failed to list apps for org: org id must be supplied"))
+	}
+
+	return s.allApps(ctx, fmt.Sprintf("%v/%v", orgsBasePath, orgID), opts)
+}
+
+func (s *AppsService) GetAppForOrg(ctx context.Context, orgID, appID string) (*App, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to get app for org: org id must be supplied")
+	}
+	if appID == "" {
+		return nil, nil, errors.New("failed to get app for org: app id must be supplied")
+	}
+
+	return s.getApp(ctx, fmt.Sprintf("%v/%v", orgsBasePath, orgID), appID)
+}
+
+func (s *AppsService) CreateAppForOrg(ctx context.Context, orgID string, createRequest *AppCreateRequest) (*App, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to create app for org: org id must be supplied")
+	}
+	if createRequest == nil {
+		return nil, nil, errors.New("failed to create app for org: payload must be supplied")
+	}
+
+	return s.createApp(ctx, fmt.Sprintf("%v/%v", orgsBasePath, orgID), createRequest)
+}
+
+func (s *AppsService) UpdateAppForOrg(ctx context.Context, orgID, appID string, updateRequest *AppUpdateRequest) (*App, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to update app for org: org id must be supplied")
+	}
+	if appID == "" {
+		return nil, nil, errors.New("failed to update app for org: app id must be supplied")
+	}
+	if updateRequest == nil {
+		return nil, nil, errors.New("failed to update app for org: payload must be supplied")
+	}
+
+	return s.updateApp(ctx, fmt.Sprintf("%v/%v", orgsBasePath, orgID), appID, updateRequest)
+}
+
+func (s *AppsService) DeleteAppFromOrg(ctx context.Context, orgID, appID string) (*Response, error) {
+	if orgID == "" {
+		return nil, errors.New("failed to delete app from org: org id must be supplied")
+	}
+	if appID == "" {
+		return nil, errors.New("failed to delete app from org: app id must be supplied")
+	}
+
+	return s.deleteApp(ctx, fmt.Sprintf("%v/%v", orgsBasePath, orgID), appID)
+}
+
+func (s *AppsService) ManageAppSecretsForOrg(ctx context.Context, orgID, appID string, mode AppSecretMode, secret string) (*App, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to manage app secrets for org: org id must be supplied")
+	}
+	if appID == "" {
+		return nil, nil, errors.New("failed to manage app secrets for org: app id must be supplied")
+	}
+	if mode == AppSecretModeDelete && secret == "" {
+		return nil, nil, errors.New("failed to manage app secrets for org: secret must be supplied")
+	}
+
+	return s.manageAppSecrets(ctx, fmt.Sprintf("%v/%v", orgsBasePath, orgID), appID, mode, secret)
+}
+
+func (s *AppsService) ListAppsForGroup(ctx context.Context, groupID string, opts *ListOptions) ([]App, *Response, error) {
+	if groupID == "" {
+		return nil, nil, errors.New("failed to list apps for group: group id must be supplied")
+	}
+
+	return s.listApps(ctx, fmt.Sprintf("%v/%v", groupsBasePath, groupID), opts)
+}
+
+func (s *AppsService) AllAppsForGroup(ctx context.Context, groupID string, opts *ListOptions) (iter.Seq2[App, *Response], func() error) {
+	if groupID == "" {
+		return errorPaginator[App](errors.New("failed to list apps for group: group id must be supplied"))
+	}
+
+	return s.allApps(ctx, fmt.Sprintf("%v/%v", groupsBasePath, groupID), opts)
+}
+
+func (s *AppsService) GetAppForGroup(ctx context.Context, groupID, appID string) (*App, *Response, error) {
+	if groupID == "" {
+		return nil, nil, errors.New("failed to get app for group: group id must be supplied")
+	}
+	if appID == "" {
+		return nil, nil, errors.New("failed to get app for group: app id must be supplied")
+	}
+
+	return s.getApp(ctx, fmt.Sprintf("%v/%v", groupsBasePath, groupID), appID)
+}
+
+func (s *AppsService) CreateAppForGroup(ctx context.Context, groupID string, createRequest *AppCreateRequest) (*App, *Response, error) {
+	if groupID == "" {
+		return nil, nil, errors.New("failed to create app for group: group id must be supplied")
+	}
+	if createRequest == nil {
+		return nil, nil, errors.New("failed to create app for group: payload must be supplied")
+	}
+
+	return s.createApp(ctx, fmt.Sprintf("%v/%v", groupsBasePath, groupID), createRequest)
+}
+
+func (s *AppsService) UpdateAppForGroup(ctx context.Context, groupID, appID string, updateRequest *AppUpdateRequest) (*App, *Response, error) {
+	if groupID == "" {
+		return nil, nil, errors.New("failed to update app for group: group id must be supplied")
+	}
+	if appID == "" {
+		return nil, nil, errors.New("failed to update app for group: app id must be supplied")
+	}
+	if updateRequest == nil {
+		return nil, nil, errors.New("failed to update app for group: payload must be supplied")
+	}
+
+	return s.updateApp(ctx, fmt.Sprintf("%v/%v", groupsBasePath, groupID), appID, updateRequest)
+}
+
+func (s *AppsService) DeleteAppFromGroup(ctx context.Context, groupID, appID string) (*Response, error) {
+	if groupID == "" {
+		return nil, errors.New("failed to delete app from group: group id must be supplied")
+	}
+	if appID == "" {
+		return nil, errors.New("failed to delete app from group: app id must be supplied")
+	}
+
+	return s.deleteApp(ctx, fmt.Sprintf("%v/%v", groupsBasePath, groupID), appID)
+}
+
+func (s *AppsService) ManageAppSecretsForGroup(ctx context.Context, groupID, appID string, mode AppSecretMode, secret string) (*App, *Response, error) {
+	if groupID == "" {
+		return nil, nil, errors.New("failed to manage app secrets for group: group id must be supplied")
+	}
+	if appID == "" {
+		return nil, nil, errors.New("failed to manage app secrets for group: app id must be supplied")
+	}
+	if mode == AppSecretModeDelete && secret == "" {
+		return nil, nil, errors.New("failed to manage app secrets for group: secret must be supplied")
+	}
+
+	return s.manageAppSecrets(ctx, fmt.Sprintf("%v/%v", groupsBasePath, groupID), appID, mode, secret)
+}
+
+func (s *AppsService) ListAppBotsForOrg(ctx context.Context, orgID string, opts *ListOptions) ([]AppBot, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to list app bots for org: org id must be supplied")
+	}
+
+	if opts == nil {
+		opts = &ListOptions{}
+	}
+	if opts.Version == "" {
+		opts.Version = appsAPIVersion
+	}
+
+	path, err := addOptions(fmt.Sprintf("%v/%v/app_bots", orgsBasePath, orgID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, s.client.restBaseURL, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(appBotsRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if l := root.Links; l != nil {
+		resp.Links = l
+	}
+
+	return root.AppBots, resp, nil
+}
+
+// listApps lists the apps created at the scope, i.e. 'orgs/{org_id}' or 'groups/{group_id}'.
+func (s *AppsService) listApps(ctx context.Context, scopePath string, opts *ListOptions) ([]App, *Response, error) {
+	if opts == nil {
+		opts = &ListOptions{}
+	}
+	if opts.Version == "" {
+		opts.Version = appsAPIVersion
+	}
+
+	path, err := addOptions(fmt.Sprintf("%v/%v/creations", scopePath, appsBasePath), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, s.client.restBaseURL, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(appsRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if l := root.Links; l != nil {
+		resp.Links = l
+	}
+
+	return root.Apps, resp, nil
+}
+
+func (s *AppsService) allApps(ctx context.Context, scopePath string, opts *ListOptions) (iter.Seq2[App, *Response], func() error) {
+	if opts == nil {
+		opts = &ListOptions{}
+	}
+	if opts.Version == "" {
+		opts.Version = appsAPIVersion
+	}
+	return newPaginator[App](ctx, s.client, s.client.restBaseURL, fmt.Sprintf("%v/%v/creations", scopePath, appsBasePath), opts)
+}
+
+func (s *AppsService) getApp(ctx context.Context, scopePath, appID string) (*App, *Response, error) {
+	opts := &BaseOptions{Version: appsAPIVersion}
+	path, err := addOptions(fmt.Sprintf("%v/%v/creations/%v", scopePath, appsBasePath, appID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, s.client.restBaseURL, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(appRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Data, resp, nil
+}
+
+func (s *AppsService) createApp(ctx context.Context, scopePath string, createRequest *AppCreateRequest) (*App, *Response, error) {
+	opts := &BaseOptions{Version: appsAPIVersion}
+	path, err := addOptions(fmt.Sprintf("%v/%v/creations", scopePath, appsBasePath), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// inline jsonapi create payload to keep create function simple
+	var createRequestJSON struct {
+		Data struct {
+			Attributes struct {
+				AccessTokenTTLSeconds int        `json:"access_token_ttl_seconds,omitempty"`
+				Context               AppContext `json:"context,omitempty"`
+				Name                  string     `json:"name"`
+				RedirectURIs          []string   `json:"redirect_uris"`
+				Scopes                []string   `json:"scopes"`
+			} `json:"attributes"`
+			Type string `json:"type"`
+		} `json:"data"`
+	}
+	createRequestJSON.Data.Attributes.AccessTokenTTLSeconds = createRequest.AccessTokenTTLSeconds
+	createRequestJSON.Data.Attributes.Context = createRequest.Context
+	createRequestJSON.Data.Attributes.Name = createRequest.Name
+	createRequestJSON.Data.Attributes.RedirectURIs = createRequest.RedirectURIs
+	createRequestJSON.Data.Attributes.Scopes = createRequest.Scopes
+	createRequestJSON.Data.Type = "app"
+
+	req, err := s.client.prepareRequest(ctx, http.MethodPost, s.client.restBaseURL, path, createRequestJSON)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(appRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Data, resp, nil
+}
+
+func (s *AppsService) updateApp(ctx context.Context, scopePath, appID string, updateRequest *AppUpdateRequest) (*App, *Response, error) {
+	opts := &BaseOptions{Version: appsAPIVersion}
+	path, err := addOptions(fmt.Sprintf("%v/%v/creations/%v", scopePath, appsBasePath, appID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// inline jsonapi update payload to keep update function simple
+	var updateRequestJSON struct {
+		Data struct {
+			Attributes struct {
+				AccessTokenTTLSeconds int      `json:"access_token_ttl_seconds,omitempty"`
+				Name                  string   `json:"name,omitempty"`
+				RedirectURIs          []string `json:"redirect_uris,omitempty"`
+			} `json:"attributes"`
+			ID   string `json:"id"`
+			Type string `json:"type"`
+		} `json:"data"`
+	}
+	updateRequestJSON.Data.Attributes.AccessTokenTTLSeconds = updateRequest.AccessTokenTTLSeconds
+	updateRequestJSON.Data.Attributes.Name = updateRequest.Name
+	updateRequestJSON.Data.Attributes.RedirectURIs = updateRequest.RedirectURIs
+	updateRequestJSON.Data.ID = appID
+	updateRequestJSON.Data.Type = "app"
+
+	req, err := s.client.prepareRequest(ctx, http.MethodPatch, s.client.restBaseURL, path, updateRequestJSON)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(appRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Data, resp, nil
+}
+
+func (s *AppsService) deleteApp(ctx context.Context, scopePath, appID string) (*Response, error) {
+	opts := &BaseOptions{Version: appsAPIVersion}
+	path, err := addOptions(fmt.Sprintf("%v/%v/creations/%v", scopePath, appsBasePath, appID), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodDelete, s.client.restBaseURL, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
+
+func (s *AppsService) manageAppSecrets(ctx context.Context, scopePath, appID string, mode AppSecretMode, secret string) (*App, *Response, error) {
+	opts := &BaseOptions{Version: appsAPIVersion}
+	path, err := addOptions(fmt.Sprintf("%v/%v/creations/%v/secrets", scopePath, appsBasePath, appID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// inline jsonapi payload to keep function simple
+	var secretsRequestJSON struct {
+		Data struct {
+			Attributes struct {
+				Mode   AppSecretMode `json:"mode"`
+				Secret string        `json:"secret,omitempty"`
+			} `json:"attributes"`
+			Type string `json:"type"`
+		} `json:"data"`
+	}
+	secretsRequestJSON.Data.Attributes.Mode = mode
+	secretsRequestJSON.Data.Attributes.Secret = secret
+	secretsRequestJSON.Data.Type = "app"
+
+	req, err := s.client.prepareRequest(ctx, http.MethodPost, s.client.restBaseURL, path, secretsRequestJSON)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(appRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Data, resp, nil
+}
+
+// listAppInstalls lists the app installs of the scope, i.e. 'orgs/{org_id}', 'groups/{group_id}' or 'self'.
+func (s *AppsService) listAppInstalls(ctx context.Context, scopePath string, opts *ListAppInstallOptions) ([]AppInstall, *Response, error) {
 	if opts == nil {
 		opts = &ListAppInstallOptions{Expand: "app"}
 	}
 	opts.Version = appsAPIVersion
 
-	path, err := addOptions(fmt.Sprintf("orgs/%v/%v/installs", orgID, appsBasePath), opts)
+	path, err := addOptions(fmt.Sprintf("%v/%v/installs", scopePath, appsBasePath), opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -127,16 +832,17 @@ func (s *AppsService) ListAppInstallsForOrg(ctx context.Context, orgID string, o
 	return root.AppInstalls, resp, nil
 }
 
-func (s *AppsService) CreateAppInstallForOrg(ctx context.Context, orgID, appID string) (*AppInstall, *Response, error) {
-	if orgID == "" {
-		return nil, nil, errors.New("failed to create app install for org: org id must be supplied")
-	}
-	if appID == "" {
-		return nil, nil, errors.New("failed to create app install for org: app id must be supplied")
+func (s *AppsService) allAppInstalls(ctx context.Context, scopePath string, opts *ListAppInstallOptions) (iter.Seq2[AppInstall, *Response], func() error) {
+	if opts == nil {
+		opts = &ListAppInstallOptions{Expand: "app"}
 	}
+	opts.Version = appsAPIVersion
+	return newPaginator[AppInstall](ctx, s.client, s.client.restBaseURL, fmt.Sprintf("%v/%v/installs", scopePath, appsBasePath), opts)
+}
 
+func (s *AppsService) createAppInstall(ctx context.Context, scopePath, appID string) (*AppInstall, *Response, error) {
 	opts := &ListOptions{BaseOptions: BaseOptions{Version: appsAPIVersion}}
-	path, err := addOptions(fmt.Sprintf("orgs/%v/%v/installs", orgID, appsBasePath), opts)
+	path, err := addOptions(fmt.Sprintf("%v/%v/installs", scopePath, appsBasePath), opts)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -172,16 +878,9 @@ func (s *AppsService) CreateAppInstallForOrg(ctx context.Context, orgID, appID s
 	return appInstallRoot.AppInstall, resp, nil
 }
 
-func (s *AppsService) DeleteAppInstallFromOrg(ctx context.Context, orgID, appInstallID string) (*Response, error) {
-	if orgID == "" {
-		return nil, errors.New("failed to delete app install for org: org id must be supplied")
-	}
-	if appInstallID == "" {
-		return nil, errors.New("failed to delete app install for org: app install id must be supplied")
-	}
-
+func (s *AppsService) deleteAppInstall(ctx context.Context, scopePath, appInstallID string) (*Response, error) {
 	opts := &ListOptions{BaseOptions: BaseOptions{Version: appsAPIVersion}}
-	path, err := addOptions(fmt.Sprintf("orgs/%v/%v/installs/%v", orgID, appsBasePath, appInstallID), opts)
+	path, err := addOptions(fmt.Sprintf("%v/%v/installs/%v", scopePath, appsBasePath, appInstallID), opts)
 	if err != nil {
 		return nil, err
 	}
@@ -193,3 +892,38 @@ func (s *AppsService) DeleteAppInstallFromOrg(ctx context.Context, orgID, appIns
 
 	return s.client.do(ctx, req, nil)
 }
+
+func (s *AppsService) manageAppInstallSecrets(ctx context.Context, scopePath, appInstallID string, mode AppSecretMode, secret string) (*AppInstall, *Response, error) {
+	opts := &BaseOptions{Version: appsAPIVersion}
+	path, err := addOptions(fmt.Sprintf("%v/%v/installs/%v/secrets", scopePath, appsBasePath, appInstallID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// inline jsonapi payload to keep function simple
+	var secretsRequestJSON struct {
+		Data struct {
+			Attributes struct {
+				Mode   AppSecretMode `json:"mode"`
+				Secret string        `json:"secret,omitempty"`
+			} `json:"attributes"`
+			Type string `json:"type"`
+		} `json:"data"`
+	}
+	secretsRequestJSON.Data.Attributes.Mode = mode
+	secretsRequestJSON.Data.Attributes.Secret = secret
+	secretsRequestJSON.Data.Type = "app"
+
+	req, err := s.client.prepareRequest(ctx, http.MethodPost, s.client.restBaseURL, path, secretsRequestJSON)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(appInstallRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.AppInstall, resp, nil
+}
@@ -0,0 +1,363 @@
+package snyk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApps_AllAppsForOrg(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/apps/creations", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, appsAPIVersion, r.URL.Query().Get("version"))
+		if r.URL.Query().Get("starting_after") == "" {
+			_, _ = fmt.Fprint(w, `{
+  "data": [ { "id": "app-1", "type": "app", "attributes": { "client_id": "client-1", "name": "First App" } } ],
+  "links": { "next": "/orgs/org-id/apps/creations?starting_after=cursor-1" }
+}`)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{ "data": [ { "id": "app-2", "type": "app", "attributes": { "client_id": "client-2", "name": "Second App" } } ], "links": {} }`)
+	})
+
+	var appIDs []string
+	apps, errFn := client.Apps.AllAppsForOrg(ctx, "org-id", nil)
+	for app := range apps {
+		appIDs = append(appIDs, app.ID)
+	}
+
+	assert.NoError(t, errFn())
+	assert.Equal(t, []string{"app-1", "app-2"}, appIDs)
+}
+
+func TestApps_AllAppsForOrg_emptyOrgID(t *testing.T) {
+	apps, errFn := client.Apps.AllAppsForOrg(ctx, "", nil)
+	for range apps {
+		t.Fatal("no apps expected")
+	}
+
+	assert.ErrorContains(t, errFn(), "org id must be supplied")
+}
+
+func TestApps_GetAppForGroup(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/groups/group-id/apps/creations/app-id", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		_, _ = fmt.Fprint(w, `
+{
+  "data": {
+    "id": "app-id",
+    "type": "app",
+    "attributes": {
+      "access_token_ttl_seconds": 3600,
+      "client_id": "client-id",
+      "context": "tenant",
+      "is_public": false,
+      "name": "My App",
+      "redirect_uris": [ "https://example.com/callback" ],
+      "scopes": [ "org.read" ]
+    }
+  }
+}
+`)
+	})
+	expectedApp := &App{
+		ID:   "app-id",
+		Type: "app",
+		Attributes: &AppAttributes{
+			AccessTokenTTLSeconds: 3600,
+			ClientID:              "client-id",
+			Context:               "tenant",
+			Name:                  "My App",
+			RedirectURIs:          []string{"https://example.com/callback"},
+			Scopes:                []string{"org.read"},
+		},
+	}
+
+	actualApp, _, err := client.Apps.GetAppForGroup(ctx, "group-id", "app-id")
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedApp, actualApp)
+}
+
+func TestApps_CreateAppForOrg(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/apps/creations", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		expectedBody := map[string]any{
+			"data": map[string]any{
+				"type": "app",
+				"attributes": map[string]any{
+					"access_token_ttl_seconds": float64(3600),
+					"context":                  "user",
+					"name":                     "My App",
+					"redirect_uris":            []any{"https://example.com/callback"},
+					"scopes":                   []any{"org.read"},
+				},
+			},
+		}
+		assert.Equal(t, expectedBody, body)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `{ "data": { "id": "app-id", "type": "app", "attributes": { "client_id": "client-id", "client_secret": "secret", "name": "My App" } } }`)
+	})
+
+	app, _, err := client.Apps.CreateAppForOrg(ctx, "org-id", &AppCreateRequest{
+		AccessTokenTTLSeconds: 3600,
+		Context:               AppContextUser,
+		Name:                  "My App",
+		RedirectURIs:          []string{"https://example.com/callback"},
+		Scopes:                []string{"org.read"},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "app-id", app.ID)
+	assert.Equal(t, "secret", app.Attributes.ClientSecret)
+}
+
+func TestApps_CreateAppForOrg_emptyPayload(t *testing.T) {
+	_, _, err := client.Apps.CreateAppForOrg(ctx, "org-id", nil)
+
+	assert.ErrorContains(t, err, "payload must be supplied")
+}
+
+func TestApps_UpdateAppForGroup(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/groups/group-id/apps/creations/app-id", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		expectedBody := map[string]any{
+			"data": map[string]any{
+				"id":   "app-id",
+				"type": "app",
+				"attributes": map[string]any{
+					"name": "Renamed App",
+				},
+			},
+		}
+		assert.Equal(t, expectedBody, body)
+		_, _ = fmt.Fprint(w, `{ "data": { "id": "app-id", "type": "app", "attributes": { "client_id": "client-id", "name": "Renamed App" } } }`)
+	})
+
+	app, _, err := client.Apps.UpdateAppForGroup(ctx, "group-id", "app-id", &AppUpdateRequest{Name: "Renamed App"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Renamed App", app.Attributes.Name)
+}
+
+func TestApps_DeleteAppFromOrg(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/apps/creations/app-id", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Apps.DeleteAppFromOrg(ctx, "org-id", "app-id")
+
+	assert.NoError(t, err)
+}
+
+func TestApps_ManageAppSecretsForOrg(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/apps/creations/app-id/secrets", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		expectedBody := map[string]any{
+			"data": map[string]any{
+				"type":       "app",
+				"attributes": map[string]any{"mode": "replace"},
+			},
+		}
+		assert.Equal(t, expectedBody, body)
+		_, _ = fmt.Fprint(w, `{ "data": { "id": "app-id", "type": "app", "attributes": { "client_id": "client-id", "client_secret": "new-secret", "name": "My App" } } }`)
+	})
+
+	app, _, err := client.Apps.ManageAppSecretsForOrg(ctx, "org-id", "app-id", AppSecretModeReplace, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "new-secret", app.Attributes.ClientSecret)
+}
+
+func TestApps_ManageAppSecretsForGroup_deleteWithoutSecret(t *testing.T) {
+	_, _, err := client.Apps.ManageAppSecretsForGroup(ctx, "group-id", "app-id", AppSecretModeDelete, "")
+
+	assert.ErrorContains(t, err, "secret must be supplied")
+}
+
+func TestApps_ListAppBotsForOrg(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/app_bots", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		_, _ = fmt.Fprint(w, `
+{
+  "data": [
+    {
+      "id": "bot-id",
+      "type": "app_bot",
+      "relationships": {
+        "app": { "data": { "id": "app-id", "type": "app", "attributes": { "client_id": "client-id", "name": "My App" } } }
+      }
+    }
+  ],
+  "links": {}
+}
+`)
+	})
+
+	bots, _, err := client.Apps.ListAppBotsForOrg(ctx, "org-id", nil)
+
+	assert.NoError(t, err)
+	if assert.Len(t, bots, 1) {
+		assert.Equal(t, "bot-id", bots[0].ID)
+		assert.Equal(t, "My App", bots[0].Relationships.App.Data.Attributes.Name)
+	}
+}
+
+func TestApps_AllAppInstallsForGroup(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/groups/group-id/apps/installs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "app", r.URL.Query().Get("expand"))
+		if r.URL.Query().Get("starting_after") == "" {
+			_, _ = fmt.Fprint(w, `{
+  "data": [ { "id": "install-1", "type": "app_install", "attributes": { "client_id": "client-1", "installed_at": "2024-01-02T03:04:05Z" } } ],
+  "links": { "next": "/groups/group-id/apps/installs?starting_after=cursor-1" }
+}`)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{ "data": [ { "id": "install-2", "type": "app_install", "attributes": { "client_id": "client-2", "installed_at": "2024-01-02T03:04:05Z" } } ], "links": {} }`)
+	})
+
+	var installIDs []string
+	installs, errFn := client.Apps.AllAppInstallsForGroup(ctx, "group-id", nil)
+	for install := range installs {
+		installIDs = append(installIDs, install.ID)
+	}
+
+	assert.NoError(t, errFn())
+	assert.Equal(t, []string{"install-1", "install-2"}, installIDs)
+}
+
+func TestApps_CreateAppInstallForGroup(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/groups/group-id/apps/installs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		expectedBody := map[string]any{
+			"data": map[string]any{"type": "app_install"},
+			"relationships": map[string]any{
+				"app": map[string]any{"data": map[string]any{"id": "app-id", "type": "app"}},
+			},
+		}
+		assert.Equal(t, expectedBody, body)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `{ "data": { "id": "install-id", "type": "app_install", "attributes": { "client_id": "client-id", "client_secret": "secret", "installed_at": "2024-01-02T03:04:05Z" } } }`)
+	})
+
+	install, _, err := client.Apps.CreateAppInstallForGroup(ctx, "group-id", "app-id")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "install-id", install.ID)
+	assert.Equal(t, "secret", install.Attributes.ClientSecret)
+}
+
+func TestApps_DeleteAppInstallFromGroup_emptyGroupID(t *testing.T) {
+	_, err := client.Apps.DeleteAppInstallFromGroup(ctx, "", "install-id")
+
+	assert.ErrorContains(t, err, "group id must be supplied")
+}
+
+func TestApps_ManageAppInstallSecretsForOrg(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/apps/installs/install-id/secrets", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		expectedBody := map[string]any{
+			"data": map[string]any{
+				"type":       "app",
+				"attributes": map[string]any{"mode": "delete", "secret": "old-secret"},
+			},
+		}
+		assert.Equal(t, expectedBody, body)
+		_, _ = fmt.Fprint(w, `{ "data": { "id": "install-id", "type": "app_install", "attributes": { "client_id": "client-id", "installed_at": "2024-01-02T03:04:05Z" } } }`)
+	})
+
+	install, _, err := client.Apps.ManageAppInstallSecretsForOrg(ctx, "org-id", "install-id", AppSecretModeDelete, "old-secret")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "install-id", install.ID)
+}
+
+func TestApps_ListAppInstallsForUser(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/self/apps/installs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		_, _ = fmt.Fprint(w, `
+{
+  "data": [
+    {
+      "id": "install-id",
+      "type": "app_install",
+      "attributes": { "client_id": "client-id", "installed_at": "2024-01-02T03:04:05Z" },
+      "relationships": {
+        "app": { "data": { "id": "app-id", "type": "app", "attributes": { "client_id": "client-id", "context": "user", "name": "CLI" } } }
+      }
+    }
+  ],
+  "links": {}
+}
+`)
+	})
+
+	installs, _, err := client.Apps.ListAppInstallsForUser(ctx, nil)
+
+	assert.NoError(t, err)
+	if assert.Len(t, installs, 1) {
+		assert.Equal(t, "CLI", installs[0].Relationships.App.Data.Attributes.Name)
+	}
+}
+
+func TestApps_DeleteAppInstallFromUser(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/self/apps/installs/install-id", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Apps.DeleteAppInstallFromUser(ctx, "install-id")
+
+	assert.NoError(t, err)
+}
@@ -0,0 +1,285 @@
+package snyk
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"time"
+)
+
+const (
+	auditLogsBasePath   = "audit_logs/search"
+	auditLogsAPIVersion = "2024-10-15"
+)
+
+// AuditLogsServiceAPI is an interface for interacting with the audit logs endpoints of the Snyk API.
+//
+// See: https://docs.snyk.io/snyk-api/reference/audit-logs
+type AuditLogsServiceAPI interface {
+	// SearchGroupAuditLogs gets a page of audit logs for a group. Logs of organizations
+	// within the group are included.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/audit-logs#get-groups-group_id-audit_logs-search
+	SearchGroupAuditLogs(ctx context.Context, groupID string, opts *SearchAuditLogsOptions) ([]AuditLog, *Response, error)
+
+	// AllGroupAuditLogs returns an iterator to paginate over all audit logs of a group.
+	//
+	// This method handles the cursor logic internally by calling SearchGroupAuditLogs for each page.
+	// The return iterated can be used in a for...range loop to easily process all audit logs.
+	//
+	// Note: This function is experimental and its signature may change in a future release.
+	AllGroupAuditLogs(ctx context.Context, groupID string, opts *SearchAuditLogsOptions) (iter.Seq2[AuditLog, *Response], func() error)
+
+	// ExportGroupAuditLogs writes all audit logs of a group to w in the given format.
+	// Audit logs are written page by page, so the whole result is never held in memory.
+	ExportGroupAuditLogs(ctx context.Context, groupID string, opts *SearchAuditLogsOptions, format AuditLogExportFormat, w io.Writer) error
+
+	// SearchOrgAuditLogs gets a page of audit logs for an organization.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/audit-logs#get-orgs-org_id-audit_logs-search
+	SearchOrgAuditLogs(ctx context.Context, orgID string, opts *SearchAuditLogsOptions) ([]AuditLog, *Response, error)
+
+	// AllOrgAuditLogs returns an iterator to paginate over all audit logs of an organization.
+	//
+	// This method handles the cursor logic internally by calling SearchOrgAuditLogs for each page.
+	// The return iterated can be used in a for...range loop to easily process all audit logs.
+	//
+	// Note: This function is experimental and its signature may change in a future release.
+	AllOrgAuditLogs(ctx context.Context, orgID string, opts *SearchAuditLogsOptions) (iter.Seq2[AuditLog, *Response], func() error)
+
+	// ExportOrgAuditLogs writes all audit logs of an organization to w in the given format.
+	// Audit logs are written page by page, so the whole result is never held in memory.
+	ExportOrgAuditLogs(ctx context.Context, orgID string, opts *SearchAuditLogsOptions, format AuditLogExportFormat, w io.Writer) error
+}
+
+// AuditLogsService handles communication with the audit log related methods of the Snyk API.
+type AuditLogsService service
+
+var _ AuditLogsServiceAPI = (*AuditLogsService)(nil)
+
+// AuditLog represents a single audit log entry, e.g. a change of org settings or a user role.
+type AuditLog struct {
+	Content   json.RawMessage `json:"content,omitempty"`    // The event specific payload.
+	CreatedAt time.Time       `json:"created"`              // The time the event happened.
+	Event     string          `json:"event"`                // The event type, e.g. 'org.user.add'.
+	GroupID   string          `json:"group_id,omitempty"`   // The ID of the group the event belongs to.
+	OrgID     string          `json:"org_id,omitempty"`     // The ID of the organization the event belongs to.
+	ProjectID string          `json:"project_id,omitempty"` // The ID of the project the event belongs to.
+	UserID    string          `json:"user_id,omitempty"`    // The ID of the user who triggered the event.
+}
+
+func (l AuditLog) String() string { return Stringify(l) }
+
+// AuditLogSortOrder defines the order of audit logs by creation time.
+type AuditLogSortOrder string
+
+const (
+	AuditLogSortOrderAsc  AuditLogSortOrder = "ASC"
+	AuditLogSortOrderDesc AuditLogSortOrder = "DESC"
+)
+
+// AuditLogExportFormat defines the output format of an audit log export.
+type AuditLogExportFormat string
+
+const (
+	AuditLogExportFormatNDJSON AuditLogExportFormat = "ndjson" // One JSON document per line.
+	AuditLogExportFormatCSV    AuditLogExportFormat = "csv"    // Comma separated values with a header row.
+)
+
+type SearchAuditLogsOptions struct {
+	BaseOptions
+
+	Cursor        string            `url:"cursor,omitempty"`               // The page of results starting at this cursor.
+	Size          int               `url:"size,omitempty"`                 // Number of results to return per page.
+	SortOrder     AuditLogSortOrder `url:"sort_order,omitempty"`           // The order of results by creation time.
+	From          time.Time         `url:"from,omitempty"`                 // Start of the time range (inclusive).
+	To            time.Time         `url:"to,omitempty"`                   // End of the time range (inclusive).
+	UserID        string            `url:"user_id,omitempty"`              // If set, only return logs triggered by this user.
+	ProjectID     string            `url:"project_id,omitempty"`           // If set, only return logs of this project.
+	Events        []string          `url:"events,comma,omitempty"`         // If set, only return logs of these event types.
+	ExcludeEvents []string          `url:"exclude_events,comma,omitempty"` // If set, never return logs of these event types.
+}
+
+type auditLogsRoot struct {
+	Data struct {
+		Items []AuditLog `json:"items"`
+	} `json:"data"`
+	Links *PaginatedLinks `json:"links,omitempty"`
+}
+
+func (s *AuditLogsService) SearchGroupAuditLogs(ctx context.Context, groupID string, opts *SearchAuditLogsOptions) ([]AuditLog, *Response, error) {
+	if groupID == "" {
+		return nil, nil, errors.New("failed to search group audit logs: group id must be supplied")
+	}
+
+	return s.search(ctx, fmt.Sprintf("%v/%v/%v", groupsBasePath, groupID, auditLogsBasePath), opts)
+}
+
+func (s *AuditLogsService) AllGroupAuditLogs(ctx context.Context, groupID string, opts *SearchAuditLogsOptions) (iter.Seq2[AuditLog, *Response], func() error) {
+	return s.all(ctx, opts, func(opts *SearchAuditLogsOptions) ([]AuditLog, *Response, error) {
+		return s.SearchGroupAuditLogs(ctx, groupID, opts)
+	})
+}
+
+func (s *AuditLogsService) ExportGroupAuditLogs(ctx context.Context, groupID string, opts *SearchAuditLogsOptions, format AuditLogExportFormat, w io.Writer) error {
+	auditLogs, iterErr := s.AllGroupAuditLogs(ctx, groupID, opts)
+	return exportAuditLogs(auditLogs, iterErr, format, w)
+}
+
+func (s *AuditLogsService) SearchOrgAuditLogs(ctx context.Context, orgID string, opts *SearchAuditLogsOptions) ([]AuditLog, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to search org audit logs: org id must be supplied")
+	}
+
+	return s.search(ctx, fmt.Sprintf("%v/%v/%v", orgsBasePath, orgID, auditLogsBasePath), opts)
+}
+
+func (s *AuditLogsService) AllOrgAuditLogs(ctx context.Context, orgID string, opts *SearchAuditLogsOptions) (iter.Seq2[AuditLog, *Response], func() error) {
+	return s.all(ctx, opts, func(opts *SearchAuditLogsOptions) ([]AuditLog, *Response, error) {
+		return s.SearchOrgAuditLogs(ctx, orgID, opts)
+	})
+}
+
+func (s *AuditLogsService) ExportOrgAuditLogs(ctx context.Context, orgID string, opts *SearchAuditLogsOptions, format AuditLogExportFormat, w io.Writer) error {
+	auditLogs, iterErr := s.AllOrgAuditLogs(ctx, orgID, opts)
+	return exportAuditLogs(auditLogs, iterErr, format, w)
+}
+
+func (s *AuditLogsService) search(ctx context.Context, endpointURL string, opts *SearchAuditLogsOptions) ([]AuditLog, *Response, error) {
+	if opts == nil {
+		opts = &SearchAuditLogsOptions{}
+	}
+	if opts.Version == "" {
+		opts.Version = auditLogsAPIVersion
+	}
+
+	path, err := addOptions(endpointURL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, s.client.restBaseURL, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(auditLogsRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if l := root.Links; l != nil {
+		resp.Links = l
+	}
+
+	return root.Data.Items, resp, nil
+}
+
+// all follows the "cursor" query parameter of the next link, as audit logs don't use
+// the "starting_after" pagination supported by newPaginator.
+func (s *AuditLogsService) all(ctx context.Context, opts *SearchAuditLogsOptions, searchFunc func(*SearchAuditLogsOptions) ([]AuditLog, *Response, error)) (iter.Seq2[AuditLog, *Response], func() error) {
+	var iterErr error
+
+	if opts == nil {
+		opts = &SearchAuditLogsOptions{}
+	}
+
+	seq := func(yield func(item AuditLog, resp *Response) bool) {
+		for {
+			select {
+			// if the context has been canceled, the context's error is more useful
+			case <-ctx.Done():
+				iterErr = ctx.Err()
+				return
+			default:
+			}
+
+			auditLogs, resp, err := searchFunc(opts)
+			if err != nil {
+				iterErr = err
+				return
+			}
+
+			for _, auditLog := range auditLogs {
+				if !yield(auditLog, resp) {
+					// stop iteration if the consumer stops
+					return
+				}
+			}
+
+			if resp.Links == nil || resp.Links.Next == "" || len(auditLogs) == 0 {
+				// no more next pages, exit from pagination
+				break
+			}
+
+			cursor, err := extractQueryParam(resp.Links.Next, "cursor")
+			if err != nil {
+				iterErr = fmt.Errorf("failed to extract cursor query param: %w", err)
+				return
+			}
+			if cursor == "" {
+				break
+			}
+			opts.Cursor = cursor
+		}
+	}
+
+	return seq, func() error { return iterErr }
+}
+
+var auditLogCSVHeader = []string{"created", "event", "group_id", "org_id", "project_id", "user_id", "content"}
+
+func exportAuditLogs(auditLogs iter.Seq2[AuditLog, *Response], iterErr func() error, format AuditLogExportFormat, w io.Writer) error {
+	if w == nil {
+		return errors.New("failed to export audit logs: writer must be supplied")
+	}
+
+	switch format {
+	case AuditLogExportFormatNDJSON:
+		encoder := json.NewEncoder(w)
+		for auditLog := range auditLogs {
+			if err := encoder.Encode(auditLog); err != nil {
+				return fmt.Errorf("failed to export audit logs: %w", err)
+			}
+		}
+	case AuditLogExportFormatCSV:
+		csvWriter := csv.NewWriter(w)
+		if err := csvWriter.Write(auditLogCSVHeader); err != nil {
+			return fmt.Errorf("failed to export audit logs: %w", err)
+		}
+		var lastResp *Response
+		for auditLog, resp := range auditLogs {
+			// flush once per page to keep memory usage bounded by the page size
+			if lastResp != nil && resp != lastResp {
+				csvWriter.Flush()
+			}
+			lastResp = resp
+
+			record := []string{
+				auditLog.CreatedAt.Format(time.RFC3339Nano),
+				auditLog.Event,
+				auditLog.GroupID,
+				auditLog.OrgID,
+				auditLog.ProjectID,
+				auditLog.UserID,
+				string(auditLog.Content),
+			}
+			if err := csvWriter.Write(record); err != nil {
+				return fmt.Errorf("failed to export audit logs: %w", err)
+			}
+		}
+		csvWriter.Flush()
+		if err := csvWriter.Error(); err != nil {
+			return fmt.Errorf("failed to export audit logs: %w", err)
+		}
+	default:
+		return fmt.Errorf("failed to export audit logs: unsupported format %q", format)
+	}
+
+	return iterErr()
+}
@@ -0,0 +1,152 @@
+package snyk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditLogs_SearchGroupAuditLogs(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/groups/group-id/audit_logs/search", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "2024-01-01T00:00:00Z", r.URL.Query().Get("from"))
+		assert.Equal(t, "", r.URL.Query().Get("to"))
+		assert.Equal(t, "DESC", r.URL.Query().Get("sort_order"))
+		assert.Equal(t, "org.create,org.delete", r.URL.Query().Get("events"))
+		assert.Equal(t, "api.access", r.URL.Query().Get("exclude_events"))
+		_, _ = fmt.Fprint(w, `
+{
+  "jsonapi": { "version": "1.0" },
+  "data": {
+    "items": [
+      {
+        "created": "2024-01-02T03:04:05Z",
+        "event": "org.create",
+        "group_id": "group-id",
+        "org_id": "org-id",
+        "user_id": "user-id",
+        "content": { "name": "Test Org" }
+      }
+    ]
+  },
+  "links": {}
+}
+`)
+	})
+	expectedAuditLogs := []AuditLog{{
+		Content:   json.RawMessage(`{ "name": "Test Org" }`),
+		CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		Event:     "org.create",
+		GroupID:   "group-id",
+		OrgID:     "org-id",
+		UserID:    "user-id",
+	}}
+
+	actualAuditLogs, _, err := client.AuditLogs.SearchGroupAuditLogs(ctx, "group-id", &SearchAuditLogsOptions{
+		From:          time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		SortOrder:     AuditLogSortOrderDesc,
+		Events:        []string{"org.create", "org.delete"},
+		ExcludeEvents: []string{"api.access"},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedAuditLogs, actualAuditLogs)
+}
+
+func TestAuditLogs_SearchGroupAuditLogs_emptyGroupID(t *testing.T) {
+	_, _, err := client.AuditLogs.SearchGroupAuditLogs(ctx, "", nil)
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "group id must be supplied")
+}
+
+func TestAuditLogs_SearchOrgAuditLogs_emptyOrgID(t *testing.T) {
+	_, _, err := client.AuditLogs.SearchOrgAuditLogs(ctx, "", nil)
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "org id must be supplied")
+}
+
+func handleTwoAuditLogPages(t *testing.T, pattern string) {
+	mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "project-id", r.URL.Query().Get("project_id"))
+		if r.URL.Query().Get("cursor") == "" {
+			_, _ = fmt.Fprint(w, `
+{
+  "data": { "items": [ { "created": "2024-01-02T03:04:05Z", "event": "org.project.add", "project_id": "project-id" } ] },
+  "links": { "next": "/orgs/org-id/audit_logs/search?cursor=next-cursor&project_id=project-id" }
+}
+`)
+			return
+		}
+		assert.Equal(t, "next-cursor", r.URL.Query().Get("cursor"))
+		_, _ = fmt.Fprint(w, `
+{
+  "data": { "items": [ { "created": "2024-01-03T03:04:05Z", "event": "org.project.remove", "project_id": "project-id", "content": {"a":"b"} } ] },
+  "links": {}
+}
+`)
+	})
+}
+
+func TestAuditLogs_AllOrgAuditLogs(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handleTwoAuditLogPages(t, "/orgs/org-id/audit_logs/search")
+
+	var actualEvents []string
+	auditLogs, iterErr := client.AuditLogs.AllOrgAuditLogs(ctx, "org-id", &SearchAuditLogsOptions{ProjectID: "project-id"})
+	for auditLog := range auditLogs {
+		actualEvents = append(actualEvents, auditLog.Event)
+	}
+
+	assert.NoError(t, iterErr())
+	assert.Equal(t, []string{"org.project.add", "org.project.remove"}, actualEvents)
+}
+
+func TestAuditLogs_ExportOrgAuditLogs_ndjson(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handleTwoAuditLogPages(t, "/orgs/org-id/audit_logs/search")
+
+	buf := new(bytes.Buffer)
+	err := client.AuditLogs.ExportOrgAuditLogs(ctx, "org-id", &SearchAuditLogsOptions{ProjectID: "project-id"}, AuditLogExportFormatNDJSON, buf)
+
+	assert.NoError(t, err)
+	assert.Equal(t, `{"created":"2024-01-02T03:04:05Z","event":"org.project.add","project_id":"project-id"}
+{"content":{"a":"b"},"created":"2024-01-03T03:04:05Z","event":"org.project.remove","project_id":"project-id"}
+`, buf.String())
+}
+
+func TestAuditLogs_ExportGroupAuditLogs_csv(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handleTwoAuditLogPages(t, "/groups/group-id/audit_logs/search")
+
+	buf := new(bytes.Buffer)
+	err := client.AuditLogs.ExportGroupAuditLogs(ctx, "group-id", &SearchAuditLogsOptions{ProjectID: "project-id"}, AuditLogExportFormatCSV, buf)
+
+	assert.NoError(t, err)
+	assert.Equal(t, `created,event,group_id,org_id,project_id,user_id,content
+2024-01-02T03:04:05Z,org.project.add,,,project-id,,
+2024-01-03T03:04:05Z,org.project.remove,,,project-id,,"{""a"":""b""}"
+`, buf.String())
+}
+
+func TestAuditLogs_ExportGroupAuditLogs_unsupportedFormat(t *testing.T) {
+	err := client.AuditLogs.ExportGroupAuditLogs(ctx, "group-id", nil, "xml", new(bytes.Buffer))
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "unsupported format")
+}
@@ -40,13 +40,26 @@ type Client struct {
 
 	common service // reuse a single struct instead of allocating one for each service on the heap.
 
-	Apps     AppsServiceAPI
-	Brokers  BrokersServiceAPI
-	Groups   GroupsServiceAPI
-	Orgs     OrgsServiceAPI
-	OrgsV1   OrgsServiceV1API
-	Projects ProjectsServiceAPI
-	Users    UsersServiceAPI
+	Apps             AppsServiceAPI
+	AuditLogs        AuditLogsServiceAPI
+	Brokers          BrokersServiceAPI
+	Collections      CollectionsServiceAPI
+	ContainerImages  ContainerImagesServiceAPI
+	CustomBaseImages CustomBaseImagesServiceAPI
+	Exports          ExportsServiceAPI
+	Groups           GroupsServiceAPI
+	Orgs             OrgsServiceAPI
+	OrgsV1           OrgsServiceV1API
+	Packages         PackagesServiceAPI
+	Policies         PoliciesServiceAPI
+	Projects         ProjectsServiceAPI
+	ProjectsV1       ProjectsServiceV1API
+	Reporting        ReportingServiceAPI
+	SBOM             SBOMServiceAPI
+	Tenants          TenantsServiceAPI
+	Test             TestServiceAPI
+	Users            UsersServiceAPI
+	Webhooks         WebhooksServiceAPI
 }
 
 // Region is used to configure the SDK to communicate with different Snyk regional instances.
@@ -225,12 +238,25 @@ func NewClient(token string, opts ...ClientOption) (*Client, error) {
 	c.common.client = c
 
 	c.Apps = (*AppsService)(&c.common)
+	c.AuditLogs = (*AuditLogsService)(&c.common)
 	c.Brokers = (*BrokersService)(&c.common)
+	c.Collections = (*CollectionsService)(&c.common)
+	c.ContainerImages = (*ContainerImagesService)(&c.common)
+	c.CustomBaseImages = (*CustomBaseImagesService)(&c.common)
+	c.Exports = (*ExportsService)(&c.common)
 	c.Groups = (*GroupsService)(&c.common)
 	c.Orgs = (*OrgsService)(&c.common)
 	c.OrgsV1 = (*OrgsServiceV1)(&c.common)
+	c.Packages = (*PackagesService)(&c.common)
+	c.Policies = (*PoliciesService)(&c.common)
 	c.Projects = (*ProjectsService)(&c.common)
+	c.ProjectsV1 = (*ProjectsServiceV1)(&c.common)
+	c.Reporting = (*ReportingService)(&c.common)
+	c.SBOM = (*SBOMService)(&c.common)
+	c.Tenants = (*TenantsService)(&c.common)
+	c.Test = (*TestService)(&c.common)
 	c.Users = (*UsersService)(&c.common)
+	c.Webhooks = (*WebhooksService)(&c.common)
 
 	return c, nil
 }
@@ -0,0 +1,440 @@
+package snyk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+const (
+	collectionsBasePath   = orgsBasePath + "/%v/collections"
+	collectionsAPIVersion = "2024-10-15"
+)
+
+// CollectionsServiceAPI is an interface for interacting with the collections endpoints of the Snyk API.
+//
+// See: https://docs.snyk.io/snyk-api/reference/collection
+type CollectionsServiceAPI interface {
+	// List gets a paginated list of collections of an organization.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/collection#get-orgs-org_id-collections
+	List(ctx context.Context, orgID string, opts *ListCollectionsOptions) ([]Collection, *Response, error)
+
+	// All returns an iterator to paginate over all collections of an organization.
+	//
+	// This method handles the pagination logic internally by calling List for each page.
+	// The return iterated can be used in a for...range loop to easily process all collections.
+	//
+	// Note: This function is experimental and its signature may change in a future release.
+	All(ctx context.Context, orgID string, opts *ListCollectionsOptions) (iter.Seq2[Collection, *Response], func() error)
+
+	// Get provides the full details of a collection.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/collection#get-orgs-org_id-collections-collection_id
+	Get(ctx context.Context, orgID, collectionID string) (*Collection, *Response, error)
+
+	// Create makes a new collection.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/collection#post-orgs-org_id-collections
+	Create(ctx context.Context, orgID string, createRequest *CollectionCreateOrUpdateRequest) (*Collection, *Response, error)
+
+	// Update changes the details of a collection.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/collection#patch-orgs-org_id-collections-collection_id
+	Update(ctx context.Context, orgID, collectionID string, updateRequest *CollectionCreateOrUpdateRequest) (*Collection, *Response, error)
+
+	// Delete removes a collection. Projects of the collection are not deleted.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/collection#delete-orgs-org_id-collections-collection_id
+	Delete(ctx context.Context, orgID, collectionID string) (*Response, error)
+
+	// ListProjects gets a paginated list of projects within a collection.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/collection#get-orgs-org_id-collections-collection_id-relationships-projects
+	ListProjects(ctx context.Context, orgID, collectionID string, opts *ListOptions) ([]Project, *Response, error)
+
+	// AllProjects returns an iterator to paginate over all projects within a collection.
+	//
+	// This method handles the pagination logic internally by calling ListProjects for each page.
+	// The return iterated can be used in a for...range loop to easily process all projects.
+	//
+	// Note: This function is experimental and its signature may change in a future release.
+	AllProjects(ctx context.Context, orgID, collectionID string, opts *ListOptions) (iter.Seq2[Project, *Response], func() error)
+
+	// AddProjects adds projects to a collection.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/collection#post-orgs-org_id-collections-collection_id-relationships-projects
+	AddProjects(ctx context.Context, orgID, collectionID string, projectIDs []string) (*Response, error)
+
+	// RemoveProjects removes projects from a collection.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/collection#delete-orgs-org_id-collections-collection_id-relationships-projects
+	RemoveProjects(ctx context.Context, orgID, collectionID string, projectIDs []string) (*Response, error)
+}
+
+// CollectionsService handles communication with the collection related methods of the Snyk API.
+type CollectionsService service
+
+var _ CollectionsServiceAPI = (*CollectionsService)(nil)
+
+// Collection represents a named group of projects within an organization.
+//
+// See: https://docs.snyk.io/snyk-platform-administration/snyk-projects/project-collections-groupings/project-collections
+type Collection struct {
+	ID         string                `json:"id"`                   // The Collection identifier.
+	Type       string                `json:"type"`                 // The resource type `collection`.
+	Attributes *CollectionAttributes `json:"attributes,omitempty"` // The Collection resource data.
+}
+
+type CollectionAttributes struct {
+	IsGenerated   bool                   `json:"is_generated,omitempty"`   // Whether the Collection was generated by Snyk, e.g. per target.
+	IssuesCount   *CollectionIssuesCount `json:"issues_count,omitempty"`   // The number of issues in projects of the Collection.
+	Name          string                 `json:"name"`                     // The name of the Collection.
+	ProjectsCount int                    `json:"projects_count,omitempty"` // The number of projects in the Collection.
+}
+
+type CollectionIssuesCount struct {
+	Critical int `json:"critical"`
+	High     int `json:"high"`
+	Medium   int `json:"medium"`
+	Low      int `json:"low"`
+}
+
+// CollectionSortField defines the field collections are sorted by.
+type CollectionSortField string
+
+const (
+	CollectionSortFieldIssues        CollectionSortField = "issues"
+	CollectionSortFieldName          CollectionSortField = "name"
+	CollectionSortFieldProjectsCount CollectionSortField = "projectsCount"
+)
+
+// SortDirection defines the direction of sorted results.
+type SortDirection string
+
+const (
+	SortDirectionAsc  SortDirection = "ASC"
+	SortDirectionDesc SortDirection = "DESC"
+)
+
+type ListCollectionsOptions struct {
+	ListOptions
+	Direction   SortDirection       `url:"direction,omitempty"`    // The sort direction.
+	IsGenerated *bool               `url:"is_generated,omitempty"` // If set, only return generated or user-defined collections.
+	Name        string              `url:"name,omitempty"`         // If set, only return collections whose name contains this value.
+	Sort        CollectionSortField `url:"sort,omitempty"`         // The field to sort by.
+}
+
+type CollectionCreateOrUpdateRequest struct {
+	Name string
+}
+
+type collectionRoot struct {
+	Collection *Collection `json:"data"`
+}
+
+type collectionsRoot struct {
+	Collections []Collection    `json:"data"`
+	Links       *PaginatedLinks `json:"links,omitempty"`
+}
+
+type collectionProjectsRequest struct {
+	Data []ResourceIdentifier `json:"data"`
+}
+
+func newCollectionProjectsRequest(projectIDs []string) collectionProjectsRequest {
+	request := collectionProjectsRequest{Data: make([]ResourceIdentifier, 0, len(projectIDs))}
+	for _, projectID := range projectIDs {
+		request.Data = append(request.Data, ResourceIdentifier{ID: projectID, Type: "project"})
+	}
+	return request
+}
+
+func (c Collection) String() string { return Stringify(c) }
+
+func (s *CollectionsService) List(ctx context.Context, orgID string, opts *ListCollectionsOptions) ([]Collection, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to list collections: org id must be supplied")
+	}
+
+	if opts == nil {
+		opts = &ListCollectionsOptions{}
+	}
+	if opts.Version == "" {
+		opts.Version = collectionsAPIVersion
+	}
+
+	path, err := addOptions(fmt.Sprintf(collectionsBasePath, orgID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, s.client.restBaseURL, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(collectionsRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if l := root.Links; l != nil {
+		resp.Links = l
+	}
+
+	return root.Collections, resp, nil
+}
+
+func (s *CollectionsService) All(ctx context.Context, orgID string, opts *ListCollectionsOptions) (iter.Seq2[Collection, *Response], func() error) {
+	if orgID == "" {
+		return errorPaginator[Collection](errors.New("failed to list collections: org id must be supplied"))
+	}
+
+	if opts == nil {
+		opts = &ListCollectionsOptions{}
+	}
+	if opts.Version == "" {
+		opts.Version = collectionsAPIVersion
+	}
+	return newPaginator[Collection](ctx, s.client, s.client.restBaseURL, fmt.Sprintf(collectionsBasePath, orgID), opts)
+}
+
+func (s *CollectionsService) Get(ctx context.Context, orgID, collectionID string) (*Collection, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to get collection: org id must be supplied")
+	}
+	if collectionID == "" {
+		return nil, nil, errors.New("failed to get collection: id must be supplied")
+	}
+
+	opts := BaseOptions{Version: collectionsAPIVersion}
+
+	path, err := addOptions(fmt.Sprintf(collectionsBasePath+"/%v", orgID, collectionID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, s.client.restBaseURL, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(collectionRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Collection, resp, nil
+}
+
+func (s *CollectionsService) Create(ctx context.Context, orgID string, createRequest *CollectionCreateOrUpdateRequest) (*Collection, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to create collection: org id must be supplied")
+	}
+	if createRequest == nil {
+		return nil, nil, errors.New("failed to create collection: payload must be supplied")
+	}
+
+	opts := BaseOptions{Version: collectionsAPIVersion}
+
+	path, err := addOptions(fmt.Sprintf(collectionsBasePath, orgID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// inline jsonapi create payload to keep create function simple
+	var createRequestJSON struct {
+		Data struct {
+			Attributes struct {
+				Name string `json:"name"`
+			} `json:"attributes"`
+			Type string `json:"type"`
+		} `json:"data"`
+	}
+	createRequestJSON.Data.Attributes.Name = createRequest.Name
+	createRequestJSON.Data.Type = "collection"
+
+	req, err := s.client.prepareRequest(ctx, http.MethodPost, s.client.restBaseURL, path, createRequestJSON)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(collectionRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Collection, resp, nil
+}
+
+func (s *CollectionsService) Update(ctx context.Context, orgID, collectionID string, updateRequest *CollectionCreateOrUpdateRequest) (*Collection, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to update collection: org id must be supplied")
+	}
+	if collectionID == "" {
+		return nil, nil, errors.New("failed to update collection: id must be supplied")
+	}
+	if updateRequest == nil {
+		return nil, nil, errors.New("failed to update collection: payload must be supplied")
+	}
+
+	opts := BaseOptions{Version: collectionsAPIVersion}
+
+	path, err := addOptions(fmt.Sprintf(collectionsBasePath+"/%v", orgID, collectionID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// inline jsonapi update payload to keep update function simple
+	var updateRequestJSON struct {
+		Data struct {
+			Attributes struct {
+				Name string `json:"name"`
+			} `json:"attributes"`
+			ID   string `json:"id"`
+			Type string `json:"type"`
+		} `json:"data"`
+	}
+	updateRequestJSON.Data.Attributes.Name = updateRequest.Name
+	updateRequestJSON.Data.ID = collectionID
+	updateRequestJSON.Data.Type = "collection"
+
+	req, err := s.client.prepareRequest(ctx, http.MethodPatch, s.client.restBaseURL, path, updateRequestJSON)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(collectionRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Collection, resp, nil
+}
+
+func (s *CollectionsService) Delete(ctx context.Context, orgID, collectionID string) (*Response, error) {
+	if orgID == "" {
+		return nil, errors.New("failed to delete collection: org id must be supplied")
+	}
+	if collectionID == "" {
+		return nil, errors.New("failed to delete collection: id must be supplied")
+	}
+
+	opts := BaseOptions{Version: collectionsAPIVersion}
+
+	path, err := addOptions(fmt.Sprintf(collectionsBasePath+"/%v", orgID, collectionID), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodDelete, s.client.restBaseURL, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
+
+func (s *CollectionsService) ListProjects(ctx context.Context, orgID, collectionID string, opts *ListOptions) ([]Project, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to list collection projects: org id must be supplied")
+	}
+	if collectionID == "" {
+		return nil, nil, errors.New("failed to list collection projects: collection id must be supplied")
+	}
+
+	if opts == nil {
+		opts = &ListOptions{}
+	}
+	if opts.Version == "" {
+		opts.Version = collectionsAPIVersion
+	}
+
+	path, err := addOptions(fmt.Sprintf(collectionsBasePath+"/%v/relationships/projects", orgID, collectionID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, s.client.restBaseURL, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(projectsRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if l := root.Links; l != nil {
+		resp.Links = l
+	}
+
+	return root.Projects, resp, nil
+}
+
+func (s *CollectionsService) AllProjects(ctx context.Context, orgID, collectionID string, opts *ListOptions) (iter.Seq2[Project, *Response], func() error) {
+	if orgID == "" {
+		return errorPaginator[Project](errors.New("failed to list collection projects: org id must be supplied"))
+	}
+	if collectionID == "" {
+		return errorPaginator[Project](errors.New("failed to list collection projects: collection id must be supplied"))
+	}
+
+	if opts == nil {
+		opts = &ListOptions{}
+	}
+	if opts.Version == "" {
+		opts.Version = collectionsAPIVersion
+	}
+	return newPaginator[Project](ctx, s.client, s.client.restBaseURL, fmt.Sprintf(collectionsBasePath+"/%v/relationships/projects", orgID, collectionID), opts)
+}
+
+func (s *CollectionsService) AddProjects(ctx context.Context, orgID, collectionID string, projectIDs []string) (*Response, error) {
+	if orgID == "" {
+		return nil, errors.New("failed to add projects to collection: org id must be supplied")
+	}
+	if collectionID == "" {
+		return nil, errors.New("failed to add projects to collection: collection id must be supplied")
+	}
+	if len(projectIDs) == 0 {
+		return nil, errors.New("failed to add projects to collection: project ids must be supplied")
+	}
+
+	return s.changeProjects(ctx, http.MethodPost, orgID, collectionID, projectIDs)
+}
+
+func (s *CollectionsService) RemoveProjects(ctx context.Context, orgID, collectionID string, projectIDs []string) (*Response, error) {
+	if orgID == "" {
+		return nil, errors.New("failed to remove projects from collection: org id must be supplied")
+	}
+	if collectionID == "" {
+		return nil, errors.New("failed to remove projects from collection: collection id must be supplied")
+	}
+	if len(projectIDs) == 0 {
+		return nil, errors.New("failed to remove projects from collection: project ids must be supplied")
+	}
+
+	return s.changeProjects(ctx, http.MethodDelete, orgID, collectionID, projectIDs)
+}
+
+func (s *CollectionsService) changeProjects(ctx context.Context, method, orgID, collectionID string, projectIDs []string) (*Response, error) {
+	opts := BaseOptions{Version: collectionsAPIVersion}
+
+	path, err := addOptions(fmt.Sprintf(collectionsBasePath+"/%v/relationships/projects", orgID, collectionID), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, method, s.client.restBaseURL, path, newCollectionProjectsRequest(projectIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
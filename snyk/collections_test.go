@@ -0,0 +1,196 @@
+package snyk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollections_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/collections", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "name", r.URL.Query().Get("sort"))
+		assert.Equal(t, "DESC", r.URL.Query().Get("direction"))
+		assert.Equal(t, "false", r.URL.Query().Get("is_generated"))
+		_, _ = fmt.Fprint(w, `
+{
+  "data": [
+    {
+      "id": "collection-id",
+      "type": "collection",
+      "attributes": {
+        "name": "payments",
+        "projects_count": 2,
+        "issues_count": { "critical": 1, "high": 2, "medium": 3, "low": 4 }
+      }
+    }
+  ],
+  "links": {}
+}
+`)
+	})
+	expectedCollections := []Collection{{
+		ID:   "collection-id",
+		Type: "collection",
+		Attributes: &CollectionAttributes{
+			IssuesCount:   &CollectionIssuesCount{Critical: 1, High: 2, Medium: 3, Low: 4},
+			Name:          "payments",
+			ProjectsCount: 2,
+		},
+	}}
+
+	isGenerated := false
+	actualCollections, _, err := client.Collections.List(ctx, "org-id", &ListCollectionsOptions{
+		Direction:   SortDirectionDesc,
+		IsGenerated: &isGenerated,
+		Sort:        CollectionSortFieldName,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedCollections, actualCollections)
+}
+
+func TestCollections_List_emptyOrgID(t *testing.T) {
+	_, _, err := client.Collections.List(ctx, "", nil)
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "org id must be supplied")
+}
+
+func TestCollections_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/collections", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]any{
+			"data": map[string]any{"type": "collection", "attributes": map[string]any{"name": "payments"}},
+		}, body)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `{ "data": { "id": "collection-id", "type": "collection", "attributes": { "name": "payments" } } }`)
+	})
+
+	collection, _, err := client.Collections.Create(ctx, "org-id", &CollectionCreateOrUpdateRequest{Name: "payments"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "collection-id", collection.ID)
+	assert.Equal(t, "payments", collection.Attributes.Name)
+}
+
+func TestCollections_Create_emptyPayload(t *testing.T) {
+	_, _, err := client.Collections.Create(ctx, "org-id", nil)
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "payload must be supplied")
+}
+
+func TestCollections_Update(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/collections/collection-id", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]any{
+			"data": map[string]any{"id": "collection-id", "type": "collection", "attributes": map[string]any{"name": "billing"}},
+		}, body)
+		_, _ = fmt.Fprint(w, `{ "data": { "id": "collection-id", "type": "collection", "attributes": { "name": "billing" } } }`)
+	})
+
+	collection, _, err := client.Collections.Update(ctx, "org-id", "collection-id", &CollectionCreateOrUpdateRequest{Name: "billing"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "billing", collection.Attributes.Name)
+}
+
+func TestCollections_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/collections/collection-id", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Collections.Delete(ctx, "org-id", "collection-id")
+
+	assert.NoError(t, err)
+}
+
+func TestCollections_AllProjects(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/collections/collection-id/relationships/projects", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		if r.URL.Query().Get("starting_after") == "" {
+			_, _ = fmt.Fprint(w, `{
+  "data": [ { "id": "project-1", "type": "project" } ],
+  "links": { "next": "/orgs/org-id/collections/collection-id/relationships/projects?starting_after=cursor-1" }
+}`)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{ "data": [ { "id": "project-2", "type": "project" } ], "links": {} }`)
+	})
+
+	var projectIDs []string
+	projects, errFn := client.Collections.AllProjects(ctx, "org-id", "collection-id", nil)
+	for project := range projects {
+		projectIDs = append(projectIDs, project.ID)
+	}
+
+	assert.NoError(t, errFn())
+	assert.Equal(t, []string{"project-1", "project-2"}, projectIDs)
+}
+
+func TestCollections_AddProjects(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/collections/collection-id/relationships/projects", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]any{
+			"data": []any{
+				map[string]any{"id": "project-1", "type": "project"},
+				map[string]any{"id": "project-2", "type": "project"},
+			},
+		}, body)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Collections.AddProjects(ctx, "org-id", "collection-id", []string{"project-1", "project-2"})
+
+	assert.NoError(t, err)
+}
+
+func TestCollections_RemoveProjects(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/collections/collection-id/relationships/projects", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Collections.RemoveProjects(ctx, "org-id", "collection-id", []string{"project-1"})
+
+	assert.NoError(t, err)
+}
+
+func TestCollections_RemoveProjects_emptyProjectIDs(t *testing.T) {
+	_, err := client.Collections.RemoveProjects(ctx, "org-id", "collection-id", nil)
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "project ids must be supplied")
+}
@@ -0,0 +1,246 @@
+package snyk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+const (
+	containerImagesBasePath   = orgsBasePath + "/%v/container_images"
+	containerImagesAPIVersion = "2024-10-15"
+)
+
+// ContainerImagesServiceAPI is an interface for interacting with the container images endpoints of the Snyk API.
+//
+// See: https://docs.snyk.io/snyk-api/reference/containerimage
+type ContainerImagesServiceAPI interface {
+	// List gets a paginated list of container images known to an organization.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/containerimage#get-orgs-org_id-container_images
+	List(ctx context.Context, orgID string, opts *ListContainerImagesOptions) ([]ContainerImage, *Response, error)
+
+	// All returns an iterator to paginate over all container images known to an organization.
+	//
+	// This method handles the pagination logic internally by calling List for each page.
+	// The return iterated can be used in a for...range loop to easily process all container images.
+	//
+	// Note: This function is experimental and its signature may change in a future release.
+	All(ctx context.Context, orgID string, opts *ListContainerImagesOptions) (iter.Seq2[ContainerImage, *Response], func() error)
+
+	// Get provides the full details of a container image.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/containerimage#get-orgs-org_id-container_images-image_id
+	Get(ctx context.Context, orgID, imageID string) (*ContainerImage, *Response, error)
+
+	// ListImageTargetRefs gets a paginated list of target references of a container image,
+	// i.e. the targets in which the image is used.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/containerimage#get-orgs-org_id-container_images-image_id-relationships-image_target_refs
+	ListImageTargetRefs(ctx context.Context, orgID, imageID string, opts *ListOptions) ([]ImageTargetRef, *Response, error)
+
+	// AllImageTargetRefs returns an iterator to paginate over all target references of a container image.
+	//
+	// This method handles the pagination logic internally by calling ListImageTargetRefs for each page.
+	// The return iterated can be used in a for...range loop to easily process all target references.
+	//
+	// Note: This function is experimental and its signature may change in a future release.
+	AllImageTargetRefs(ctx context.Context, orgID, imageID string, opts *ListOptions) (iter.Seq2[ImageTargetRef, *Response], func() error)
+}
+
+// ContainerImagesService handles communication with the container image related methods of the Snyk API.
+type ContainerImagesService service
+
+var _ ContainerImagesServiceAPI = (*ContainerImagesService)(nil)
+
+// ContainerImage represents a container image scanned by Snyk.
+type ContainerImage struct {
+	ID            string                       `json:"id"`                      // The ContainerImage identifier, i.e. the image digest.
+	Type          string                       `json:"type"`                    // The resource type `container_image`.
+	Attributes    *ContainerImageAttributes    `json:"attributes,omitempty"`    // The ContainerImage resource data.
+	Relationships *ContainerImageRelationships `json:"relationships,omitempty"` // The relationships object describing relationships between ContainerImage and its targets.
+}
+
+type ContainerImageAttributes struct {
+	Layers   []string `json:"layers,omitempty"`   // The digests of the image layers.
+	Names    []string `json:"names,omitempty"`    // The names (repository and tag) the image is known by.
+	Platform string   `json:"platform,omitempty"` // The platform of the image, e.g. 'linux/amd64'.
+}
+
+type ContainerImageRelationships struct {
+	ImageTargetRefs *Relationship `json:"image_target_refs,omitempty"`
+}
+
+// ImageTargetRef represents a reference from a container image to a target using it.
+type ImageTargetRef struct {
+	ID            string                       `json:"id"`                      // The ImageTargetRef identifier.
+	Type          string                       `json:"type"`                    // The resource type `image_target_ref`.
+	Attributes    *ImageTargetRefAttributes    `json:"attributes,omitempty"`    // The ImageTargetRef resource data.
+	Relationships *ImageTargetRefRelationships `json:"relationships,omitempty"` // The relationships object describing relationships between ImageTargetRef and Target.
+}
+
+type ImageTargetRefAttributes struct {
+	TargetReference string `json:"target_reference,omitempty"` // The reference of the image in the target, e.g. the tag.
+}
+
+type ImageTargetRefRelationships struct {
+	Target *Relationship `json:"target,omitempty"`
+}
+
+type ListContainerImagesOptions struct {
+	ListOptions
+	ImageIDs []string `url:"image_ids,comma,omitempty"` // If set, only return images with these IDs.
+	Names    []string `url:"names,comma,omitempty"`     // If set, only return images with these names.
+	Platform string   `url:"platform,omitempty"`        // If set, only return images for this platform, e.g. 'linux/amd64'.
+}
+
+type containerImageRoot struct {
+	ContainerImage *ContainerImage `json:"data"`
+}
+
+type containerImagesRoot struct {
+	ContainerImages []ContainerImage `json:"data"`
+	Links           *PaginatedLinks  `json:"links,omitempty"`
+}
+
+type imageTargetRefsRoot struct {
+	ImageTargetRefs []ImageTargetRef `json:"data"`
+	Links           *PaginatedLinks  `json:"links,omitempty"`
+}
+
+func (ci ContainerImage) String() string { return Stringify(ci) }
+
+func (r ImageTargetRef) String() string { return Stringify(r) }
+
+func (s *ContainerImagesService) List(ctx context.Context, orgID string, opts *ListContainerImagesOptions) ([]ContainerImage, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to list container images: org id must be supplied")
+	}
+
+	if opts == nil {
+		opts = &ListContainerImagesOptions{}
+	}
+	if opts.Version == "" {
+		opts.Version = containerImagesAPIVersion
+	}
+
+	path, err := addOptions(fmt.Sprintf(containerImagesBasePath, orgID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, s.client.restBaseURL, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(containerImagesRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if l := root.Links; l != nil {
+		resp.Links = l
+	}
+
+	return root.ContainerImages, resp, nil
+}
+
+func (s *ContainerImagesService) All(ctx context.Context, orgID string, opts *ListContainerImagesOptions) (iter.Seq2[ContainerImage, *Response], func() error) {
+	if orgID == "" {
+		return errorPaginator[ContainerImage](errors.New("failed to list container images: org id must be supplied"))
+	}
+
+	if opts == nil {
+		opts = &ListContainerImagesOptions{}
+	}
+	if opts.Version == "" {
+		opts.Version = containerImagesAPIVersion
+	}
+	return newPaginator[ContainerImage](ctx, s.client, s.client.restBaseURL, fmt.Sprintf(containerImagesBasePath, orgID), opts)
+}
+
+func (s *ContainerImagesService) Get(ctx context.Context, orgID, imageID string) (*ContainerImage, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to get container image: org id must be supplied")
+	}
+	if imageID == "" {
+		return nil, nil, errors.New("failed to get container image: id must be supplied")
+	}
+
+	opts := BaseOptions{Version: containerImagesAPIVersion}
+
+	path, err := addOptions(fmt.Sprintf(containerImagesBasePath+"/%v", orgID, imageID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, s.client.restBaseURL, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(containerImageRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.ContainerImage, resp, nil
+}
+
+func (s *ContainerImagesService) ListImageTargetRefs(ctx context.Context, orgID, imageID string, opts *ListOptions) ([]ImageTargetRef, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to list image target refs: org id must be supplied")
+	}
+	if imageID == "" {
+		return nil, nil, errors.New("failed to list image target refs: image id must be supplied")
+	}
+
+	if opts == nil {
+		opts = &ListOptions{}
+	}
+	if opts.Version == "" {
+		opts.Version = containerImagesAPIVersion
+	}
+
+	path, err := addOptions(fmt.Sprintf(containerImagesBasePath+"/%v/relationships/image_target_refs", orgID, imageID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, s.client.restBaseURL, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(imageTargetRefsRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if l := root.Links; l != nil {
+		resp.Links = l
+	}
+
+	return root.ImageTargetRefs, resp, nil
+}
+
+func (s *ContainerImagesService) AllImageTargetRefs(ctx context.Context, orgID, imageID string, opts *ListOptions) (iter.Seq2[ImageTargetRef, *Response], func() error) {
+	if orgID == "" {
+		return errorPaginator[ImageTargetRef](errors.New("failed to list image target refs: org id must be supplied"))
+	}
+	if imageID == "" {
+		return errorPaginator[ImageTargetRef](errors.New("failed to list image target refs: image id must be supplied"))
+	}
+
+	if opts == nil {
+		opts = &ListOptions{}
+	}
+	if opts.Version == "" {
+		opts.Version = containerImagesAPIVersion
+	}
+	return newPaginator[ImageTargetRef](ctx, s.client, s.client.restBaseURL, fmt.Sprintf(containerImagesBasePath+"/%v/relationships/image_target_refs", orgID, imageID), opts)
+}
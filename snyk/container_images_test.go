@@ -0,0 +1,138 @@
+package snyk
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainerImages_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/container_images", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "linux/amd64", r.URL.Query().Get("platform"))
+		assert.Equal(t, "nginx:1.25,nginx:latest", r.URL.Query().Get("names"))
+		_, _ = fmt.Fprint(w, `
+{
+  "jsonapi": { "version": "1.0" },
+  "data": [
+    {
+      "id": "sha256:a1b2c3",
+      "type": "container_image",
+      "attributes": {
+        "layers": [ "sha256:l1", "sha256:l2" ],
+        "names": [ "nginx:1.25", "nginx:latest" ],
+        "platform": "linux/amd64"
+      },
+      "relationships": {
+        "image_target_refs": {
+          "links": { "related": "/orgs/org-id/container_images/sha256:a1b2c3/relationships/image_target_refs" }
+        }
+      }
+    }
+  ],
+  "links": {}
+}
+`)
+	})
+	expectedImages := []ContainerImage{{
+		ID:   "sha256:a1b2c3",
+		Type: "container_image",
+		Attributes: &ContainerImageAttributes{
+			Layers:   []string{"sha256:l1", "sha256:l2"},
+			Names:    []string{"nginx:1.25", "nginx:latest"},
+			Platform: "linux/amd64",
+		},
+		Relationships: &ContainerImageRelationships{
+			ImageTargetRefs: &Relationship{
+				Links: &PaginatedLinks{Related: "/orgs/org-id/container_images/sha256:a1b2c3/relationships/image_target_refs"},
+			},
+		},
+	}}
+
+	actualImages, _, err := client.ContainerImages.List(ctx, "org-id", &ListContainerImagesOptions{
+		Names:    []string{"nginx:1.25", "nginx:latest"},
+		Platform: "linux/amd64",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedImages, actualImages)
+}
+
+func TestContainerImages_List_emptyOrgID(t *testing.T) {
+	_, _, err := client.ContainerImages.List(ctx, "", nil)
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "org id must be supplied")
+}
+
+func TestContainerImages_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/container_images/image-id", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		_, _ = fmt.Fprint(w, `{ "data": { "id": "image-id", "type": "container_image", "attributes": { "platform": "linux/arm64" } } }`)
+	})
+	expectedImage := &ContainerImage{ID: "image-id", Type: "container_image", Attributes: &ContainerImageAttributes{Platform: "linux/arm64"}}
+
+	actualImage, _, err := client.ContainerImages.Get(ctx, "org-id", "image-id")
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedImage, actualImage)
+}
+
+func TestContainerImages_Get_emptyImageID(t *testing.T) {
+	_, _, err := client.ContainerImages.Get(ctx, "org-id", "")
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "id must be supplied")
+}
+
+func TestContainerImages_AllImageTargetRefs(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/container_images/image-id/relationships/image_target_refs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		if r.URL.Query().Get("starting_after") == "" {
+			_, _ = fmt.Fprint(w, `
+{
+  "data": [
+    {
+      "id": "ref-1",
+      "type": "image_target_ref",
+      "attributes": { "target_reference": "1.25" },
+      "relationships": { "target": { "data": { "id": "target-1", "type": "target" } } }
+    }
+  ],
+  "links": { "next": "/orgs/org-id/container_images/image-id/relationships/image_target_refs?starting_after=ref-1" }
+}
+`)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{ "data": [ { "id": "ref-2", "type": "image_target_ref" } ], "links": {} }`)
+	})
+	expectedRefs := []ImageTargetRef{
+		{
+			ID:            "ref-1",
+			Type:          "image_target_ref",
+			Attributes:    &ImageTargetRefAttributes{TargetReference: "1.25"},
+			Relationships: &ImageTargetRefRelationships{Target: &Relationship{Data: &ResourceIdentifier{ID: "target-1", Type: "target"}}},
+		},
+		{ID: "ref-2", Type: "image_target_ref"},
+	}
+
+	var actualRefs []ImageTargetRef
+	refs, iterErr := client.ContainerImages.AllImageTargetRefs(ctx, "org-id", "image-id", nil)
+	for ref := range refs {
+		actualRefs = append(actualRefs, ref)
+	}
+
+	assert.NoError(t, iterErr())
+	assert.Equal(t, expectedRefs, actualRefs)
+}
@@ -0,0 +1,348 @@
+package snyk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+	"regexp"
+)
+
+const (
+	customBaseImagesBasePath   = "custom_base_images"
+	customBaseImagesAPIVersion = "2024-10-15"
+)
+
+// CustomBaseImagesServiceAPI is an interface for interacting with the custom base images endpoints of the Snyk API.
+//
+// See: https://docs.snyk.io/snyk-api/reference/custom-base-images
+type CustomBaseImagesServiceAPI interface {
+	// List gets a paginated list of custom base images.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/custom-base-images#get-custom_base_images
+	List(ctx context.Context, opts *ListCustomBaseImagesOptions) ([]CustomBaseImage, *Response, error)
+
+	// All returns an iterator to paginate over all custom base images.
+	//
+	// This method handles the pagination logic internally by calling List for each page.
+	// The return iterated can be used in a for...range loop to easily process all custom base images.
+	//
+	// Note: This function is experimental and its signature may change in a future release.
+	All(ctx context.Context, opts *ListCustomBaseImagesOptions) (iter.Seq2[CustomBaseImage, *Response], func() error)
+
+	// Get provides the full details of a custom base image.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/custom-base-images#get-custom_base_images-custombaseimage_id
+	Get(ctx context.Context, customBaseImageID string) (*CustomBaseImage, *Response, error)
+
+	// Create registers the container image of a project as a custom base image. The versioning
+	// schema is validated before the request is sent.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/custom-base-images#post-custom_base_images
+	Create(ctx context.Context, createRequest *CustomBaseImageCreateRequest) (*CustomBaseImage, *Response, error)
+
+	// Update changes a custom base image. Only supplied fields are changed.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/custom-base-images#patch-custom_base_images-custombaseimage_id
+	Update(ctx context.Context, customBaseImageID string, updateRequest *CustomBaseImageUpdateRequest) (*CustomBaseImage, *Response, error)
+
+	// Delete removes a custom base image. The underlying project is not deleted.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/custom-base-images#delete-custom_base_images-custombaseimage_id
+	Delete(ctx context.Context, customBaseImageID string) (*Response, error)
+}
+
+// CustomBaseImagesService handles communication with the custom base image related methods of the Snyk API.
+type CustomBaseImagesService service
+
+var _ CustomBaseImagesServiceAPI = (*CustomBaseImagesService)(nil)
+
+// CustomBaseImage represents a container image registered to be recommended as base image upgrade.
+//
+// See: https://docs.snyk.io/scan-with-snyk/snyk-container/use-snyk-container/use-custom-base-image-recommendations
+type CustomBaseImage struct {
+	ID         string                     `json:"id"`                   // The CustomBaseImage identifier.
+	Type       string                     `json:"type"`                 // The resource type `custom_base_image`.
+	Attributes *CustomBaseImageAttributes `json:"attributes,omitempty"` // The CustomBaseImage resource data.
+}
+
+type CustomBaseImageAttributes struct {
+	GroupID                  string            `json:"group_id,omitempty"`          // The ID of the group the image belongs to.
+	IncludeInRecommendations bool              `json:"include_in_recommendations"`  // Whether the image is recommended as upgrade.
+	OrgID                    string            `json:"org_id,omitempty"`            // The ID of the organization the image belongs to.
+	ProjectID                string            `json:"project_id"`                  // The ID of the container project the image is imported by.
+	Repository               string            `json:"repository,omitempty"`        // The repository of the image.
+	Tag                      string            `json:"tag,omitempty"`               // The tag of the image.
+	VersioningSchema         *VersioningSchema `json:"versioning_schema,omitempty"` // How image tags are compared with each other.
+}
+
+// VersioningSchemaType defines how the tags of custom base images in a repository are ordered.
+type VersioningSchemaType string
+
+const (
+	VersioningSchemaTypeCustom          VersioningSchemaType = "custom"           // Tags are parsed with a regular expression.
+	VersioningSchemaTypeSemVer          VersioningSchemaType = "semver"           // Tags follow semantic versioning.
+	VersioningSchemaTypeSingleSelection VersioningSchemaType = "single-selection" // A single image is always recommended.
+)
+
+// VersioningSchema configures how image tags are compared to recommend upgrades.
+type VersioningSchema struct {
+	Type       VersioningSchemaType `json:"type"`
+	Expression string               `json:"expression,omitempty"` // The regular expression with named groups parsing a tag, only for custom.
+	Label      string               `json:"label,omitempty"`      // The label of the schema, only for custom.
+}
+
+// Validate checks that the versioning schema is complete. For custom schemas the expression must
+// be a valid regular expression with at least one named capture group.
+func (vs VersioningSchema) Validate() error {
+	switch vs.Type {
+	case VersioningSchemaTypeSemVer, VersioningSchemaTypeSingleSelection:
+		if vs.Expression != "" {
+			return fmt.Errorf("expression is only supported for %q versioning schema", VersioningSchemaTypeCustom)
+		}
+	case VersioningSchemaTypeCustom:
+		if vs.Expression == "" {
+			return errors.New("expression must be supplied for custom versioning schema")
+		}
+		re, err := regexp.Compile(vs.Expression)
+		if err != nil {
+			return fmt.Errorf("expression is not a valid regular expression: %w", err)
+		}
+		hasNamedGroup := false
+		for _, name := range re.SubexpNames() {
+			if name != "" {
+				hasNamedGroup = true
+				break
+			}
+		}
+		if !hasNamedGroup {
+			return errors.New("expression must contain at least one named capture group")
+		}
+	case "":
+		return errors.New("type must be supplied")
+	default:
+		return fmt.Errorf("unsupported versioning schema type %q", vs.Type)
+	}
+	return nil
+}
+
+type ListCustomBaseImagesOptions struct {
+	ListOptions
+	GroupID                  string `url:"group_id,omitempty"`                   // If set, only return images of this group.
+	IncludeInRecommendations *bool  `url:"include_in_recommendations,omitempty"` // If set, only return images with this recommendation state.
+	OrgID                    string `url:"org_id,omitempty"`                     // If set, only return images of this organization.
+	ProjectID                string `url:"project_id,omitempty"`                 // If set, only return the image of this project.
+	Repository               string `url:"repository,omitempty"`                 // If set, only return images of this repository.
+	SortBy                   string `url:"sort_by,omitempty"`                    // The field to sort by, e.g. 'repository', 'tag' or 'version'.
+	SortDirection            string `url:"sort_direction,omitempty"`             // The sort direction, 'ASC' or 'DESC'.
+	Tag                      string `url:"tag,omitempty"`                        // If set, only return images with this tag.
+}
+
+type CustomBaseImageCreateRequest struct {
+	IncludeInRecommendations bool
+	ProjectID                string
+	VersioningSchema         *VersioningSchema // Required for the first image of a repository.
+}
+
+type CustomBaseImageUpdateRequest struct {
+	IncludeInRecommendations *bool
+	VersioningSchema         *VersioningSchema
+}
+
+type customBaseImageRoot struct {
+	CustomBaseImage *CustomBaseImage `json:"data"`
+}
+
+type customBaseImagesRoot struct {
+	CustomBaseImages []CustomBaseImage `json:"data"`
+	Links            *PaginatedLinks   `json:"links,omitempty"`
+}
+
+func (cbi CustomBaseImage) String() string { return Stringify(cbi) }
+
+func (s *CustomBaseImagesService) List(ctx context.Context, opts *ListCustomBaseImagesOptions) ([]CustomBaseImage, *Response, error) {
+	if opts == nil {
+		opts = &ListCustomBaseImagesOptions{}
+	}
+	if opts.Version == "" {
+		opts.Version = customBaseImagesAPIVersion
+	}
+
+	path, err := addOptions(customBaseImagesBasePath, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, s.client.restBaseURL, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(customBaseImagesRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if l := root.Links; l != nil {
+		resp.Links = l
+	}
+
+	return root.CustomBaseImages, resp, nil
+}
+
+func (s *CustomBaseImagesService) All(ctx context.Context, opts *ListCustomBaseImagesOptions) (iter.Seq2[CustomBaseImage, *Response], func() error) {
+	if opts == nil {
+		opts = &ListCustomBaseImagesOptions{}
+	}
+	if opts.Version == "" {
+		opts.Version = customBaseImagesAPIVersion
+	}
+	return newPaginator[CustomBaseImage](ctx, s.client, s.client.restBaseURL, customBaseImagesBasePath, opts)
+}
+
+func (s *CustomBaseImagesService) Get(ctx context.Context, customBaseImageID string) (*CustomBaseImage, *Response, error) {
+	if customBaseImageID == "" {
+		return nil, nil, errors.New("failed to get custom base image: id must be supplied")
+	}
+
+	opts := BaseOptions{Version: customBaseImagesAPIVersion}
+
+	path, err := addOptions(fmt.Sprintf("%v/%v", customBaseImagesBasePath, customBaseImageID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, s.client.restBaseURL, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(customBaseImageRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.CustomBaseImage, resp, nil
+}
+
+func (s *CustomBaseImagesService) Create(ctx context.Context, createRequest *CustomBaseImageCreateRequest) (*CustomBaseImage, *Response, error) {
+	if createRequest == nil {
+		return nil, nil, errors.New("failed to create custom base image: payload must be supplied")
+	}
+	if createRequest.ProjectID == "" {
+		return nil, nil, errors.New("failed to create custom base image: project id must be supplied")
+	}
+	if vs := createRequest.VersioningSchema; vs != nil {
+		if err := vs.Validate(); err != nil {
+			return nil, nil, fmt.Errorf("failed to create custom base image: invalid versioning schema: %w", err)
+		}
+	}
+
+	opts := BaseOptions{Version: customBaseImagesAPIVersion}
+
+	path, err := addOptions(customBaseImagesBasePath, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// inline jsonapi create payload to keep create function simple
+	var createRequestJSON struct {
+		Data struct {
+			Attributes struct {
+				IncludeInRecommendations bool              `json:"include_in_recommendations"`
+				ProjectID                string            `json:"project_id"`
+				VersioningSchema         *VersioningSchema `json:"versioning_schema,omitempty"`
+			} `json:"attributes"`
+			Type string `json:"type"`
+		} `json:"data"`
+	}
+	createRequestJSON.Data.Attributes.IncludeInRecommendations = createRequest.IncludeInRecommendations
+	createRequestJSON.Data.Attributes.ProjectID = createRequest.ProjectID
+	createRequestJSON.Data.Attributes.VersioningSchema = createRequest.VersioningSchema
+	createRequestJSON.Data.Type = "custom_base_image"
+
+	req, err := s.client.prepareRequest(ctx, http.MethodPost, s.client.restBaseURL, path, createRequestJSON)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(customBaseImageRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.CustomBaseImage, resp, nil
+}
+
+func (s *CustomBaseImagesService) Update(ctx context.Context, customBaseImageID string, updateRequest *CustomBaseImageUpdateRequest) (*CustomBaseImage, *Response, error) {
+	if customBaseImageID == "" {
+		return nil, nil, errors.New("failed to update custom base image: id must be supplied")
+	}
+	if updateRequest == nil {
+		return nil, nil, errors.New("failed to update custom base image: payload must be supplied")
+	}
+	if vs := updateRequest.VersioningSchema; vs != nil {
+		if err := vs.Validate(); err != nil {
+			return nil, nil, fmt.Errorf("failed to update custom base image: invalid versioning schema: %w", err)
+		}
+	}
+
+	opts := BaseOptions{Version: customBaseImagesAPIVersion}
+
+	path, err := addOptions(fmt.Sprintf("%v/%v", customBaseImagesBasePath, customBaseImageID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// inline jsonapi update payload to keep update function simple
+	var updateRequestJSON struct {
+		Data struct {
+			Attributes struct {
+				IncludeInRecommendations *bool             `json:"include_in_recommendations,omitempty"`
+				VersioningSchema         *VersioningSchema `json:"versioning_schema,omitempty"`
+			} `json:"attributes"`
+			ID   string `json:"id"`
+			Type string `json:"type"`
+		} `json:"data"`
+	}
+	updateRequestJSON.Data.Attributes.IncludeInRecommendations = updateRequest.IncludeInRecommendations
+	updateRequestJSON.Data.Attributes.VersioningSchema = updateRequest.VersioningSchema
+	updateRequestJSON.Data.ID = customBaseImageID
+	updateRequestJSON.Data.Type = "custom_base_image"
+
+	req, err := s.client.prepareRequest(ctx, http.MethodPatch, s.client.restBaseURL, path, updateRequestJSON)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(customBaseImageRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.CustomBaseImage, resp, nil
+}
+
+func (s *CustomBaseImagesService) Delete(ctx context.Context, customBaseImageID string) (*Response, error) {
+	if customBaseImageID == "" {
+		return nil, errors.New("failed to delete custom base image: id must be supplied")
+	}
+
+	opts := BaseOptions{Version: customBaseImagesAPIVersion}
+
+	path, err := addOptions(fmt.Sprintf("%v/%v", customBaseImagesBasePath, customBaseImageID), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodDelete, s.client.restBaseURL, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
@@ -0,0 +1,178 @@
+package snyk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomBaseImages_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/custom_base_images", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "my-registry/base", r.URL.Query().Get("repository"))
+		assert.Equal(t, "false", r.URL.Query().Get("include_in_recommendations"))
+		_, _ = fmt.Fprint(w, `
+{
+  "data": [
+    {
+      "id": "cbi-id",
+      "type": "custom_base_image",
+      "attributes": {
+        "project_id": "project-id",
+        "org_id": "org-id",
+        "group_id": "group-id",
+        "repository": "my-registry/base",
+        "tag": "1.2.3",
+        "include_in_recommendations": false,
+        "versioning_schema": { "type": "semver" }
+      }
+    }
+  ],
+  "links": {}
+}
+`)
+	})
+	expectedImages := []CustomBaseImage{{
+		ID:   "cbi-id",
+		Type: "custom_base_image",
+		Attributes: &CustomBaseImageAttributes{
+			GroupID:          "group-id",
+			OrgID:            "org-id",
+			ProjectID:        "project-id",
+			Repository:       "my-registry/base",
+			Tag:              "1.2.3",
+			VersioningSchema: &VersioningSchema{Type: VersioningSchemaTypeSemVer},
+		},
+	}}
+	includeInRecommendations := false
+
+	actualImages, _, err := client.CustomBaseImages.List(ctx, &ListCustomBaseImagesOptions{
+		IncludeInRecommendations: &includeInRecommendations,
+		Repository:               "my-registry/base",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedImages, actualImages)
+}
+
+func TestCustomBaseImages_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/custom_base_images", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, map[string]any{
+			"data": map[string]any{
+				"attributes": map[string]any{
+					"include_in_recommendations": true,
+					"project_id":                 "project-id",
+					"versioning_schema":          map[string]any{"type": "custom", "expression": `^(?P<C>\d+)-(?P<M>\d+)$`},
+				},
+				"type": "custom_base_image",
+			},
+		}, body)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `{ "data": { "id": "cbi-id", "type": "custom_base_image", "attributes": { "project_id": "project-id", "include_in_recommendations": true } } }`)
+	})
+	expectedImage := &CustomBaseImage{
+		ID:         "cbi-id",
+		Type:       "custom_base_image",
+		Attributes: &CustomBaseImageAttributes{IncludeInRecommendations: true, ProjectID: "project-id"},
+	}
+
+	actualImage, _, err := client.CustomBaseImages.Create(ctx, &CustomBaseImageCreateRequest{
+		IncludeInRecommendations: true,
+		ProjectID:                "project-id",
+		VersioningSchema:         &VersioningSchema{Type: VersioningSchemaTypeCustom, Expression: `^(?P<C>\d+)-(?P<M>\d+)$`},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedImage, actualImage)
+}
+
+func TestCustomBaseImages_Create_invalidVersioningSchema(t *testing.T) {
+	_, _, err := client.CustomBaseImages.Create(ctx, &CustomBaseImageCreateRequest{
+		ProjectID:        "project-id",
+		VersioningSchema: &VersioningSchema{Type: VersioningSchemaTypeCustom, Expression: `^(\d+$`},
+	})
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "invalid versioning schema")
+}
+
+func TestCustomBaseImages_Update(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/custom_base_images/cbi-id", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, map[string]any{
+			"data": map[string]any{
+				"attributes": map[string]any{"include_in_recommendations": false},
+				"id":         "cbi-id",
+				"type":       "custom_base_image",
+			},
+		}, body)
+		_, _ = fmt.Fprint(w, `{ "data": { "id": "cbi-id", "type": "custom_base_image", "attributes": { "project_id": "project-id" } } }`)
+	})
+	includeInRecommendations := false
+
+	actualImage, _, err := client.CustomBaseImages.Update(ctx, "cbi-id", &CustomBaseImageUpdateRequest{IncludeInRecommendations: &includeInRecommendations})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "cbi-id", actualImage.ID)
+}
+
+func TestCustomBaseImages_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/custom_base_images/cbi-id", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.CustomBaseImages.Delete(ctx, "cbi-id")
+
+	assert.NoError(t, err)
+}
+
+func TestVersioningSchema_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		schema        VersioningSchema
+		errorExpected bool
+	}{
+		"semver":                        {schema: VersioningSchema{Type: VersioningSchemaTypeSemVer}},
+		"single-selection":              {schema: VersioningSchema{Type: VersioningSchemaTypeSingleSelection}},
+		"custom":                        {schema: VersioningSchema{Type: VersioningSchemaTypeCustom, Expression: `^(?<M>\d+)\.(?<m>\d+)$`}},
+		"error-empty-type":              {schema: VersioningSchema{}, errorExpected: true},
+		"error-unknown-type":            {schema: VersioningSchema{Type: "calver"}, errorExpected: true},
+		"error-semver-with-expression":  {schema: VersioningSchema{Type: VersioningSchemaTypeSemVer, Expression: `.*`}, errorExpected: true},
+		"error-custom-empty-expression": {schema: VersioningSchema{Type: VersioningSchemaTypeCustom}, errorExpected: true},
+		"error-custom-invalid-regex":    {schema: VersioningSchema{Type: VersioningSchemaTypeCustom, Expression: `(?P<M>\d+`}, errorExpected: true},
+		"error-custom-no-named-group":   {schema: VersioningSchema{Type: VersioningSchemaTypeCustom, Expression: `(\d+)`}, errorExpected: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := test.schema.Validate()
+
+			if test.errorExpected {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
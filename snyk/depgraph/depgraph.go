@@ -0,0 +1,299 @@
+/*
+Package depgraph provides an in-memory model of the Snyk dep-graph v1 format.
+
+A Graph is created with a Builder, which validates the graph on Build, parsed from the
+dep-graph JSON with Parse or converted from other sources like the output of
+`go list -m -json all` with FromGoModules:
+
+	graph, err := depgraph.NewBuilder(depgraph.PkgManager{Name: "npm"}, depgraph.Pkg{Name: "app", Version: "1.0.0"}).
+		AddPkgNode("a@1.2.3", depgraph.Pkg{Name: "a", Version: "1.2.3"}).
+		ConnectDep(depgraph.RootNodeID, "a@1.2.3").
+		Build()
+	if err != nil {
+		return err
+	}
+	result, _, err := client.Test.TestDepGraph(ctx, orgID, graph.DepGraph())
+
+The JSON serialisation of a Graph is canonical: packages, nodes and dependencies are sorted
+by their identifiers, so equal graphs always serialise to the same bytes.
+*/
+package depgraph
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/pavel-snyk/snyk-sdk-go/v2/snyk"
+)
+
+// RootNodeID is the node ID of the root package in a Graph created by a Builder.
+const RootNodeID = snyk.DepGraphRootNodeID
+
+// The errors are the ones of the snyk package, so they match for both builders.
+var (
+	ErrCycle         = snyk.ErrDepGraphCycle
+	ErrDuplicateNode = snyk.ErrDepGraphDuplicateNode
+	ErrDuplicatePkg  = snyk.ErrDepGraphDuplicatePkg
+	ErrInvalidPkgID  = snyk.ErrDepGraphInvalidPkgID
+	ErrUnknownNode   = snyk.ErrDepGraphUnknownNode
+	ErrUnknownPkg    = snyk.ErrDepGraphUnknownPkg
+)
+
+// ErrUnsupportedSchemaVersion is returned by FromDepGraph for dep-graphs not in a 1.x schema version.
+var ErrUnsupportedSchemaVersion = errors.New("unsupported schema version")
+
+// PkgManager describes the package manager of all packages in a Graph.
+type PkgManager struct {
+	Name         string   // The name of the package manager, e.g. `gomodules` or `npm`.
+	Repositories []string // The aliases of the repositories the packages are resolved from.
+	Version      string   // The version of the package manager.
+}
+
+// Pkg is a package in a Graph, identified by name and version.
+type Pkg struct {
+	Name    string
+	Version string
+	PURL    string // The package URL, optional.
+}
+
+// ID returns the package identifier used in the dep-graph format, i.e. `name@version`.
+func (p Pkg) ID() string { return p.Name + "@" + p.Version }
+
+func (p Pkg) info() snyk.DepGraphPkgInfo {
+	return snyk.DepGraphPkgInfo{Name: p.Name, Version: p.Version, PURL: p.PURL}
+}
+
+type node struct {
+	pkgID  string
+	deps   []string
+	labels map[string]string
+}
+
+// Graph is a validated dependency graph. A Graph must not be modified after creation,
+// it is safe for concurrent reads.
+type Graph struct {
+	schemaVersion string
+	pkgManager    PkgManager
+	rootNodeID    string
+	pkgs          map[string]Pkg   // by package ID
+	nodes         map[string]*node // by node ID
+}
+
+// SchemaVersion returns the version of the dep-graph format of the graph, e.g. `1.2.0`.
+func (g *Graph) SchemaVersion() string { return g.schemaVersion }
+
+// PkgManager returns the package manager of the graph.
+func (g *Graph) PkgManager() PkgManager { return g.pkgManager }
+
+// RootNodeID returns the ID of the node of the root package.
+func (g *Graph) RootNodeID() string { return g.rootNodeID }
+
+// RootPkg returns the root package of the graph.
+func (g *Graph) RootPkg() Pkg { return g.pkgs[g.nodes[g.rootNodeID].pkgID] }
+
+// Pkgs returns all packages of the graph sorted by package ID.
+func (g *Graph) Pkgs() []Pkg {
+	pkgs := make([]Pkg, 0, len(g.pkgs))
+	for _, pkgID := range slices.Sorted(maps.Keys(g.pkgs)) {
+		pkgs = append(pkgs, g.pkgs[pkgID])
+	}
+	return pkgs
+}
+
+// NodeIDs returns the IDs of all nodes of the graph sorted, the root node first.
+func (g *Graph) NodeIDs() []string {
+	nodeIDs := slices.Collect(maps.Keys(g.nodes))
+	slices.SortFunc(nodeIDs, g.compareNodeIDs)
+	return nodeIDs
+}
+
+// NodePkg returns the package of the node.
+func (g *Graph) NodePkg(nodeID string) (Pkg, bool) {
+	n, ok := g.nodes[nodeID]
+	if !ok {
+		return Pkg{}, false
+	}
+	return g.pkgs[n.pkgID], true
+}
+
+// Deps returns the IDs of the direct dependencies of the node sorted.
+func (g *Graph) Deps(nodeID string) []string {
+	n, ok := g.nodes[nodeID]
+	if !ok {
+		return nil
+	}
+	return slices.Clone(n.deps)
+}
+
+// Labels returns the labels of the node, e.g. `scope: dev`.
+func (g *Graph) Labels(nodeID string) map[string]string {
+	n, ok := g.nodes[nodeID]
+	if !ok {
+		return nil
+	}
+	return maps.Clone(n.labels)
+}
+
+func (g *Graph) compareNodeIDs(a, b string) int {
+	switch {
+	case a == b:
+		return 0
+	case a == g.rootNodeID:
+		return -1
+	case b == g.rootNodeID:
+		return 1
+	}
+	return cmp.Compare(a, b)
+}
+
+// Builder constructs a Graph. It wraps snyk.DepGraphBuilder, so both report the same errors.
+// The first error encountered is reported by Build, so calls can be chained.
+type Builder struct {
+	pkgManager PkgManager
+	builder    *snyk.DepGraphBuilder
+}
+
+// NewBuilder creates a Builder for the package manager. The root package is added with the node ID RootNodeID.
+func NewBuilder(pkgManager PkgManager, rootPkg Pkg) *Builder {
+	return &Builder{
+		pkgManager: pkgManager,
+		builder:    snyk.NewDepGraphBuilder(pkgManager.Name, rootPkg.info()),
+	}
+}
+
+// AddPkgNode adds a node for the package. The same package can be referenced by several nodes,
+// but a package ID must always describe the same package.
+func (b *Builder) AddPkgNode(nodeID string, pkg Pkg) *Builder {
+	b.builder.AddPkgNode(nodeID, pkg.info())
+	return b
+}
+
+// ConnectDep adds an edge from the parent node to the dependency node. Both nodes must be added before.
+func (b *Builder) ConnectDep(parentNodeID, depNodeID string) *Builder {
+	b.builder.ConnectDep(parentNodeID, depNodeID)
+	return b
+}
+
+// SetLabel sets a label of the node, e.g. `scope: dev`.
+func (b *Builder) SetLabel(nodeID, key, value string) *Builder {
+	b.builder.SetLabel(nodeID, key, value)
+	return b
+}
+
+// Build validates and returns the Graph, or the first error which occurred while building it.
+// The Builder must not be used after Build.
+func (b *Builder) Build() (*Graph, error) {
+	depGraph, err := b.builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	depGraph.PkgManager.Version = b.pkgManager.Version
+	for _, alias := range b.pkgManager.Repositories {
+		depGraph.PkgManager.Repositories = append(depGraph.PkgManager.Repositories, snyk.DepGraphRepository{Alias: alias})
+	}
+	return FromDepGraph(depGraph)
+}
+
+// DepGraph converts the graph to the dep-graph type used by the snyk package, e.g. for
+// snyk.TestServiceAPI. Packages, nodes and dependencies are sorted.
+func (g *Graph) DepGraph() *snyk.DepGraph {
+	depGraph := &snyk.DepGraph{
+		SchemaVersion: g.schemaVersion,
+		PkgManager:    snyk.DepGraphPkgManager{Name: g.pkgManager.Name, Version: g.pkgManager.Version},
+		Pkgs:          make([]snyk.DepGraphPkg, 0, len(g.pkgs)),
+		Graph:         snyk.DepGraphGraph{RootNodeID: g.rootNodeID, Nodes: make([]snyk.DepGraphNode, 0, len(g.nodes))},
+	}
+	for _, alias := range g.pkgManager.Repositories {
+		depGraph.PkgManager.Repositories = append(depGraph.PkgManager.Repositories, snyk.DepGraphRepository{Alias: alias})
+	}
+	for _, pkg := range g.Pkgs() {
+		depGraph.Pkgs = append(depGraph.Pkgs, snyk.DepGraphPkg{
+			ID:   pkg.ID(),
+			Info: pkg.info(),
+		})
+	}
+	for _, nodeID := range g.NodeIDs() {
+		n := g.nodes[nodeID]
+		depGraphNode := snyk.DepGraphNode{NodeID: nodeID, PkgID: n.pkgID, Deps: make([]snyk.DepGraphNodeRef, 0, len(n.deps))}
+		for _, depID := range n.deps {
+			depGraphNode.Deps = append(depGraphNode.Deps, snyk.DepGraphNodeRef{NodeID: depID})
+		}
+		if len(n.labels) > 0 {
+			depGraphNode.Info = &snyk.DepGraphNodeInfo{Labels: maps.Clone(n.labels)}
+		}
+		depGraph.Graph.Nodes = append(depGraph.Graph.Nodes, depGraphNode)
+	}
+	return depGraph
+}
+
+// FromDepGraph converts and validates the dep-graph type used by the snyk package. The id of
+// every package must be `name@version`, as this is the id the package is serialized with.
+// Only the 1.x schema versions are supported, the schema version is kept for serialisation.
+func FromDepGraph(depGraph *snyk.DepGraph) (*Graph, error) {
+	if depGraph == nil {
+		return nil, errors.New("failed to convert dep-graph: dep-graph must be supplied")
+	}
+	if major, _, _ := strings.Cut(depGraph.SchemaVersion, "."); major != "1" {
+		return nil, fmt.Errorf("failed to convert dep-graph: %w %q", ErrUnsupportedSchemaVersion, depGraph.SchemaVersion)
+	}
+	if err := depGraph.Validate(); err != nil {
+		return nil, err
+	}
+
+	g := &Graph{
+		schemaVersion: depGraph.SchemaVersion,
+		pkgManager:    PkgManager{Name: depGraph.PkgManager.Name, Version: depGraph.PkgManager.Version},
+		rootNodeID:    depGraph.Graph.RootNodeID,
+		pkgs:          make(map[string]Pkg, len(depGraph.Pkgs)),
+		nodes:         make(map[string]*node, len(depGraph.Graph.Nodes)),
+	}
+	for _, repository := range depGraph.PkgManager.Repositories {
+		g.pkgManager.Repositories = append(g.pkgManager.Repositories, repository.Alias)
+	}
+	for _, depGraphPkg := range depGraph.Pkgs {
+		g.pkgs[depGraphPkg.ID] = Pkg{Name: depGraphPkg.Info.Name, Version: depGraphPkg.Info.Version, PURL: depGraphPkg.Info.PURL}
+	}
+	for _, depGraphNode := range depGraph.Graph.Nodes {
+		n := &node{pkgID: depGraphNode.PkgID}
+		for _, dep := range depGraphNode.Deps {
+			if i, found := slices.BinarySearch(n.deps, dep.NodeID); !found {
+				n.deps = slices.Insert(n.deps, i, dep.NodeID)
+			}
+		}
+		if depGraphNode.Info != nil && len(depGraphNode.Info.Labels) > 0 {
+			n.labels = maps.Clone(depGraphNode.Info.Labels)
+		}
+		g.nodes[depGraphNode.NodeID] = n
+	}
+
+	return g, nil
+}
+
+// Parse decodes and validates a graph in the Snyk dep-graph v1 JSON format.
+func Parse(data []byte) (*Graph, error) {
+	depGraph := new(snyk.DepGraph)
+	if err := json.Unmarshal(data, depGraph); err != nil {
+		return nil, fmt.Errorf("failed to decode dep-graph: %w", err)
+	}
+	return FromDepGraph(depGraph)
+}
+
+// MarshalJSON encodes the graph in the canonical Snyk dep-graph v1 JSON format.
+func (g *Graph) MarshalJSON() ([]byte, error) {
+	return json.Marshal(g.DepGraph())
+}
+
+// UnmarshalJSON decodes and validates a graph in the Snyk dep-graph v1 JSON format.
+func (g *Graph) UnmarshalJSON(data []byte) error {
+	parsed, err := Parse(data)
+	if err != nil {
+		return err
+	}
+	*g = *parsed
+	return nil
+}
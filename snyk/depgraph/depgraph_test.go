@@ -0,0 +1,197 @@
+package depgraph
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder_Build(t *testing.T) {
+	graph, err := NewBuilder(PkgManager{Name: "npm"}, Pkg{Name: "app", Version: "1.0.0"}).
+		AddPkgNode("b@2.0.0", Pkg{Name: "b", Version: "2.0.0"}).
+		AddPkgNode("a@1.0.0", Pkg{Name: "a", Version: "1.0.0"}).
+		ConnectDep(RootNodeID, "b@2.0.0").
+		ConnectDep(RootNodeID, "a@1.0.0").
+		ConnectDep("a@1.0.0", "b@2.0.0").
+		SetLabel("b@2.0.0", "scope", "dev").
+		Build()
+
+	assert.NoError(t, err)
+	assert.Equal(t, Pkg{Name: "app", Version: "1.0.0"}, graph.RootPkg())
+	assert.Equal(t, []string{RootNodeID, "a@1.0.0", "b@2.0.0"}, graph.NodeIDs())
+	assert.Equal(t, []string{"a@1.0.0", "b@2.0.0"}, graph.Deps(RootNodeID))
+	assert.Equal(t, map[string]string{"scope": "dev"}, graph.Labels("b@2.0.0"))
+	assert.Len(t, graph.Pkgs(), 3)
+}
+
+func TestBuilder_Build_errors(t *testing.T) {
+	tests := map[string]struct {
+		builder     *Builder
+		expectedErr error
+	}{
+		"duplicate node": {
+			builder: NewBuilder(PkgManager{Name: "npm"}, Pkg{Name: "app"}).
+				AddPkgNode("a", Pkg{Name: "a"}).
+				AddPkgNode("a", Pkg{Name: "a"}),
+			expectedErr: ErrDuplicateNode,
+		},
+		"duplicate package": {
+			builder: NewBuilder(PkgManager{Name: "npm"}, Pkg{Name: "app"}).
+				AddPkgNode("a", Pkg{Name: "a", Version: "1"}).
+				AddPkgNode("a-2", Pkg{Name: "a", Version: "1", PURL: "pkg:npm/a@1"}),
+			expectedErr: ErrDuplicatePkg,
+		},
+		"unknown node": {
+			builder: NewBuilder(PkgManager{Name: "npm"}, Pkg{Name: "app"}).
+				ConnectDep(RootNodeID, "missing"),
+			expectedErr: ErrUnknownNode,
+		},
+		"cycle": {
+			builder: NewBuilder(PkgManager{Name: "npm"}, Pkg{Name: "app"}).
+				AddPkgNode("a", Pkg{Name: "a"}).
+				AddPkgNode("b", Pkg{Name: "b"}).
+				ConnectDep(RootNodeID, "a").
+				ConnectDep("a", "b").
+				ConnectDep("b", "a"),
+			expectedErr: ErrCycle,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.builder.Build()
+
+			assert.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestGraph_MarshalJSON(t *testing.T) {
+	graph, err := NewBuilder(PkgManager{Name: "npm", Repositories: []string{"npmjs"}}, Pkg{Name: "app", Version: "1.0.0"}).
+		AddPkgNode("b@2.0.0", Pkg{Name: "b", Version: "2.0.0"}).
+		AddPkgNode("a@1.0.0", Pkg{Name: "a", Version: "1.0.0"}).
+		ConnectDep(RootNodeID, "b@2.0.0").
+		ConnectDep(RootNodeID, "a@1.0.0").
+		SetLabel("a@1.0.0", "scope", "dev").
+		Build()
+	assert.NoError(t, err)
+
+	data, err := json.Marshal(graph)
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `
+{
+  "schemaVersion": "1.2.0",
+  "pkgManager": { "name": "npm", "repositories": [ { "alias": "npmjs" } ] },
+  "pkgs": [
+    { "id": "a@1.0.0", "info": { "name": "a", "version": "1.0.0" } },
+    { "id": "app@1.0.0", "info": { "name": "app", "version": "1.0.0" } },
+    { "id": "b@2.0.0", "info": { "name": "b", "version": "2.0.0" } }
+  ],
+  "graph": {
+    "rootNodeId": "root-node",
+    "nodes": [
+      { "nodeId": "root-node", "pkgId": "app@1.0.0", "deps": [ { "nodeId": "a@1.0.0" }, { "nodeId": "b@2.0.0" } ] },
+      { "nodeId": "a@1.0.0", "pkgId": "a@1.0.0", "deps": [], "info": { "labels": { "scope": "dev" } } },
+      { "nodeId": "b@2.0.0", "pkgId": "b@2.0.0", "deps": [] }
+    ]
+  }
+}
+`, string(data))
+
+	// round trip keeps the canonical form
+	parsed := new(Graph)
+	assert.NoError(t, json.Unmarshal(data, parsed))
+	roundTrip, err := json.Marshal(parsed)
+	assert.NoError(t, err)
+	assert.Equal(t, string(data), string(roundTrip))
+}
+
+func TestParse_roundTrip(t *testing.T) {
+	data := `
+{
+  "schemaVersion": "1.3.0",
+  "pkgManager": { "name": "npm" },
+  "pkgs": [
+    { "id": "a@1.0.0", "info": { "name": "a", "version": "1.0.0", "purl": "pkg:npm/a@1.0.0" } },
+    { "id": "app@1.0.0", "info": { "name": "app", "version": "1.0.0" } }
+  ],
+  "graph": {
+    "rootNodeId": "app-node",
+    "nodes": [
+      { "nodeId": "app-node", "pkgId": "app@1.0.0", "deps": [ { "nodeId": "a-node" } ] },
+      { "nodeId": "a-node", "pkgId": "a@1.0.0", "deps": [] }
+    ]
+  }
+}
+`
+
+	graph, err := Parse([]byte(data))
+	assert.NoError(t, err)
+	serialized, err := json.Marshal(graph)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "1.3.0", graph.SchemaVersion())
+	assert.JSONEq(t, data, string(serialized))
+	reparsed, err := Parse(serialized)
+	assert.NoError(t, err)
+	assert.Equal(t, graph, reparsed)
+}
+
+func TestParse_errors(t *testing.T) {
+	tests := map[string]struct {
+		data        string
+		expectedErr error
+	}{
+		"duplicate package": {
+			data: `{ "schemaVersion": "1.2.0", "pkgManager": { "name": "npm" }, "pkgs": [ { "id": "app@", "info": { "name": "app" } }, { "id": "app@", "info": { "name": "app" } } ],
+  "graph": { "rootNodeId": "root-node", "nodes": [ { "nodeId": "root-node", "pkgId": "app@", "deps": [] } ] } }`,
+			expectedErr: ErrDuplicatePkg,
+		},
+		"package id not name@version": {
+			data: `{ "schemaVersion": "1.2.0", "pkgManager": { "name": "npm" }, "pkgs": [ { "id": "x", "info": { "name": "a", "version": "1" } } ],
+  "graph": { "rootNodeId": "root-node", "nodes": [ { "nodeId": "root-node", "pkgId": "x", "deps": [] } ] } }`,
+			expectedErr: ErrInvalidPkgID,
+		},
+		"duplicate node": {
+			data: `{ "schemaVersion": "1.2.0", "pkgManager": { "name": "npm" }, "pkgs": [ { "id": "app@", "info": { "name": "app" } } ],
+  "graph": { "rootNodeId": "root-node", "nodes": [ { "nodeId": "root-node", "pkgId": "app@", "deps": [] }, { "nodeId": "root-node", "pkgId": "app@", "deps": [] } ] } }`,
+			expectedErr: ErrDuplicateNode,
+		},
+		"unknown package": {
+			data: `{ "schemaVersion": "1.2.0", "pkgManager": { "name": "npm" }, "pkgs": [],
+  "graph": { "rootNodeId": "root-node", "nodes": [ { "nodeId": "root-node", "pkgId": "app@", "deps": [] } ] } }`,
+			expectedErr: ErrUnknownPkg,
+		},
+		"missing root node": {
+			data:        `{ "schemaVersion": "1.2.0", "pkgManager": { "name": "npm" }, "pkgs": [], "graph": { "rootNodeId": "root-node", "nodes": [] } }`,
+			expectedErr: ErrUnknownNode,
+		},
+		"unsupported schema version": {
+			data: `{ "schemaVersion": "2.0.0", "pkgManager": { "name": "npm" }, "pkgs": [ { "id": "app@", "info": { "name": "app" } } ],
+  "graph": { "rootNodeId": "root-node", "nodes": [ { "nodeId": "root-node", "pkgId": "app@", "deps": [] } ] } }`,
+			expectedErr: ErrUnsupportedSchemaVersion,
+		},
+		"missing schema version": {
+			data: `{ "pkgManager": { "name": "npm" }, "pkgs": [ { "id": "app@", "info": { "name": "app" } } ],
+  "graph": { "rootNodeId": "root-node", "nodes": [ { "nodeId": "root-node", "pkgId": "app@", "deps": [] } ] } }`,
+			expectedErr: ErrUnsupportedSchemaVersion,
+		},
+		"self cycle": {
+			data: `{ "schemaVersion": "1.2.0", "pkgManager": { "name": "npm" }, "pkgs": [ { "id": "app@", "info": { "name": "app" } } ],
+  "graph": { "rootNodeId": "root-node", "nodes": [ { "nodeId": "root-node", "pkgId": "app@", "deps": [ { "nodeId": "root-node" } ] } ] } }`,
+			expectedErr: ErrCycle,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Parse([]byte(test.data))
+
+			assert.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package depgraph
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// GoModulesPkgManager is the name of the package manager for Go modules used by Snyk.
+const GoModulesPkgManager = "gomodules"
+
+// goModule is a module as printed by `go list -m -json`.
+type goModule struct {
+	Path     string
+	Version  string
+	Main     bool
+	Indirect bool
+	Replace  *goModule
+	Error    *struct {
+		Err string
+	}
+}
+
+// FromGoModules converts the output of `go list -m -json all` into a Graph. The main module
+// becomes the root package and all other modules its direct dependencies, as the module list
+// does not contain the edges of the module graph. Indirect dependencies are labeled with
+// `indirect: true` and replaced modules are reported with the replacement version. If several
+// modules are replaced by the same module, it is indirect only if all of them are indirect.
+//
+// In a workspace with several main modules the first main module is the root package and
+// the other main modules are dependencies of it.
+func FromGoModules(r io.Reader) (*Graph, error) {
+	var modules []goModule
+	decoder := json.NewDecoder(r)
+	for {
+		var module goModule
+		err := decoder.Decode(&module)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode go modules: %w", err)
+		}
+		if module.Error != nil {
+			return nil, fmt.Errorf("failed to convert go modules: module %q: %v", module.Path, module.Error.Err)
+		}
+		modules = append(modules, module)
+	}
+
+	var b *Builder
+	for _, module := range modules {
+		if module.Main {
+			b = NewBuilder(PkgManager{Name: GoModulesPkgManager}, Pkg{Name: module.Path, Version: module.Version})
+			break
+		}
+	}
+	if b == nil {
+		return nil, errors.New("failed to convert go modules: main module not found")
+	}
+
+	rootAdded := false
+	var nodeIDs []string
+	pkgs := make(map[string]Pkg)
+	indirect := make(map[string]bool)
+	for _, module := range modules {
+		if module.Main && !rootAdded {
+			rootAdded = true
+			continue
+		}
+		pkg := Pkg{Name: module.Path, Version: module.Version}
+		if module.Replace != nil && module.Replace.Version != "" {
+			pkg = Pkg{Name: module.Replace.Path, Version: module.Replace.Version}
+		}
+
+		nodeID := pkg.ID()
+		if _, ok := pkgs[nodeID]; !ok {
+			nodeIDs = append(nodeIDs, nodeID)
+			pkgs[nodeID] = pkg
+			indirect[nodeID] = module.Indirect
+		} else {
+			// several modules can be replaced by the same module, a direct one makes the node direct
+			indirect[nodeID] = indirect[nodeID] && module.Indirect
+		}
+	}
+
+	for _, nodeID := range nodeIDs {
+		b.AddPkgNode(nodeID, pkgs[nodeID]).ConnectDep(RootNodeID, nodeID)
+		if indirect[nodeID] {
+			b.SetLabel(nodeID, "indirect", "true")
+		}
+	}
+
+	return b.Build()
+}
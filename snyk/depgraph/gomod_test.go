@@ -0,0 +1,92 @@
+package depgraph
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromGoModules(t *testing.T) {
+	output := `{
+	"Path": "example.com/app",
+	"Main": true,
+	"Dir": "/src/app",
+	"GoMod": "/src/app/go.mod",
+	"GoVersion": "1.25"
+}
+{
+	"Path": "github.com/stretchr/testify",
+	"Version": "v1.11.1",
+	"Time": "2025-08-27T10:46:31Z"
+}
+{
+	"Path": "gopkg.in/yaml.v3",
+	"Version": "v3.0.1",
+	"Indirect": true
+}
+{
+	"Path": "golang.org/x/text",
+	"Version": "v0.3.0",
+	"Replace": {
+		"Path": "golang.org/x/text",
+		"Version": "v0.21.0"
+	}
+}
+`
+
+	graph, err := FromGoModules(strings.NewReader(output))
+
+	assert.NoError(t, err)
+	assert.Equal(t, PkgManager{Name: "gomodules"}, graph.PkgManager())
+	assert.Equal(t, Pkg{Name: "example.com/app"}, graph.RootPkg())
+	assert.Equal(t, []string{"github.com/stretchr/testify@v1.11.1", "golang.org/x/text@v0.21.0", "gopkg.in/yaml.v3@v3.0.1"}, graph.Deps(RootNodeID))
+	assert.Equal(t, map[string]string{"indirect": "true"}, graph.Labels("gopkg.in/yaml.v3@v3.0.1"))
+	assert.Nil(t, graph.Labels("github.com/stretchr/testify@v1.11.1"))
+}
+
+func TestFromGoModules_sharedReplacement(t *testing.T) {
+	output := `
+{ "Path": "example.com/app", "Main": true }
+{ "Path": "example.com/old-a", "Version": "v1.0.0", "Indirect": true, "Replace": { "Path": "example.com/fork", "Version": "v1.1.0" } }
+{ "Path": "example.com/old-b", "Version": "v2.0.0", "Indirect": true, "Replace": { "Path": "example.com/fork", "Version": "v1.1.0" } }
+{ "Path": "example.com/old-c", "Version": "v1.0.0", "Replace": { "Path": "example.com/other-fork", "Version": "v0.1.0" } }
+{ "Path": "example.com/old-d", "Version": "v1.0.0", "Indirect": true, "Replace": { "Path": "example.com/other-fork", "Version": "v0.1.0" } }
+`
+
+	graph, err := FromGoModules(strings.NewReader(output))
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"example.com/fork@v1.1.0", "example.com/other-fork@v0.1.0"}, graph.Deps(RootNodeID))
+	assert.Equal(t, map[string]string{"indirect": "true"}, graph.Labels("example.com/fork@v1.1.0"))
+	assert.Nil(t, graph.Labels("example.com/other-fork@v0.1.0"))
+}
+
+func TestFromGoModules_errors(t *testing.T) {
+	tests := map[string]struct {
+		output      string
+		expectedErr string
+	}{
+		"no main module": {
+			output:      `{ "Path": "github.com/stretchr/testify", "Version": "v1.11.1" }`,
+			expectedErr: "main module not found",
+		},
+		"module error": {
+			output:      `{ "Path": "example.com/app", "Main": true } { "Path": "example.com/broken", "Error": { "Err": "not found" } }`,
+			expectedErr: `module "example.com/broken": not found`,
+		},
+		"invalid json": {
+			output:      `{ "Path": `,
+			expectedErr: "failed to decode go modules",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := FromGoModules(strings.NewReader(test.output))
+
+			assert.ErrorContains(t, err, test.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,581 @@
+package snyk
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	exportsAPIVersion = "2024-10-15"
+
+	defaultExportPollInterval = 5 * time.Second
+)
+
+// ExportsServiceAPI is an interface for interacting with the export endpoints of the Snyk API.
+//
+// An export runs asynchronously: start it with CreateGroupExport or CreateOrgExport, wait for it
+// with WaitForGroupExport or WaitForOrgExport and download its CSV results with DownloadResult
+// or decode them with DecodeExportRows.
+//
+// See: https://docs.snyk.io/snyk-api/reference/export
+type ExportsServiceAPI interface {
+	// CreateGroupExport starts an export of a dataset for a group.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/export#post-groups-group_id-export
+	CreateGroupExport(ctx context.Context, groupID string, createRequest *ExportCreateRequest) (*ExportJob, *Response, error)
+
+	// GetGroupExportJob provides the status of an export job of a group.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/export#get-groups-group_id-jobs-export-export_id
+	GetGroupExportJob(ctx context.Context, groupID, exportID string) (*ExportJob, *Response, error)
+
+	// WaitForGroupExport polls an export job of a group every pollInterval until it is finished and
+	// provides the finished export. If pollInterval is not positive, a default of 5 seconds is used.
+	// Cancel ctx to stop waiting.
+	WaitForGroupExport(ctx context.Context, groupID, exportID string, pollInterval time.Duration) (*Export, *Response, error)
+
+	// GetGroupExport provides the results of a finished export of a group.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/export#get-groups-group_id-export-export_id
+	GetGroupExport(ctx context.Context, groupID, exportID string) (*Export, *Response, error)
+
+	// CreateOrgExport starts an export of a dataset for an organization.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/export#post-orgs-org_id-export
+	CreateOrgExport(ctx context.Context, orgID string, createRequest *ExportCreateRequest) (*ExportJob, *Response, error)
+
+	// GetOrgExportJob provides the status of an export job of an organization.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/export#get-orgs-org_id-jobs-export-export_id
+	GetOrgExportJob(ctx context.Context, orgID, exportID string) (*ExportJob, *Response, error)
+
+	// WaitForOrgExport polls an export job of an organization every pollInterval until it is finished
+	// and provides the finished export. If pollInterval is not positive, a default of 5 seconds is used.
+	// Cancel ctx to stop waiting.
+	WaitForOrgExport(ctx context.Context, orgID, exportID string, pollInterval time.Duration) (*Export, *Response, error)
+
+	// GetOrgExport provides the results of a finished export of an organization.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/export#get-orgs-org_id-export-export_id
+	GetOrgExport(ctx context.Context, orgID, exportID string) (*Export, *Response, error)
+
+	// DownloadResult streams a single CSV chunk of a finished export into w.
+	DownloadResult(ctx context.Context, result ExportResult, w io.Writer) (*Response, error)
+}
+
+// ExportsService handles communication with the export related methods of the Snyk API.
+type ExportsService service
+
+var _ ExportsServiceAPI = (*ExportsService)(nil)
+
+// ExportDataset defines the data exported.
+type ExportDataset string
+
+const (
+	ExportDatasetIssues ExportDataset = "issues"
+	ExportDatasetUsage  ExportDataset = "usage"
+)
+
+// ExportStatus defines the state of an export job.
+type ExportStatus string
+
+const (
+	ExportStatusPending  ExportStatus = "PENDING"
+	ExportStatusStarted  ExportStatus = "STARTED"
+	ExportStatusFinished ExportStatus = "FINISHED"
+	ExportStatusError    ExportStatus = "ERROR"
+)
+
+// ExportJob represents the state of a running export.
+type ExportJob struct {
+	ID         string               `json:"id"`                   // The ExportJob identifier, same as the Export identifier.
+	Type       string               `json:"type"`                 // The resource type.
+	Attributes *ExportJobAttributes `json:"attributes,omitempty"` // The ExportJob resource data.
+}
+
+type ExportJobAttributes struct {
+	Created time.Time    `json:"created,omitempty"` // The time the export was started.
+	Status  ExportStatus `json:"status"`            // The state of the export.
+}
+
+// Export represents a finished export with its downloadable results.
+type Export struct {
+	ID         string            `json:"id"`                   // The Export identifier.
+	Type       string            `json:"type"`                 // The resource type `export`.
+	Attributes *ExportAttributes `json:"attributes,omitempty"` // The Export resource data.
+}
+
+type ExportAttributes struct {
+	Created  time.Time      `json:"created,omitempty"`   // The time the export was started.
+	Dataset  ExportDataset  `json:"dataset"`             // The exported dataset.
+	Results  []ExportResult `json:"results"`             // The CSV chunks of the export.
+	RowCount int            `json:"row_count,omitempty"` // The number of exported rows in all chunks.
+}
+
+// ExportResult represents a single CSV chunk of an export.
+type ExportResult struct {
+	FileSize int    `json:"file_size,omitempty"` // The size of the chunk in bytes.
+	RowCount int    `json:"row_count,omitempty"` // The number of rows in the chunk.
+	URL      string `json:"url"`                 // The pre-signed, short-lived URL of the chunk.
+}
+
+type ExportCreateRequest struct {
+	Columns []string       // The columns to export, all columns of the dataset if empty.
+	Dataset ExportDataset  // The dataset to export.
+	Filters *ExportFilters // The filters of the exported data.
+}
+
+type ExportFilters struct {
+	Environment []string         `json:"environment,omitempty"` // The project environments, e.g. 'backend'.
+	Introduced  *ExportDateRange `json:"introduced,omitempty"`  // The time range the issues were introduced in, issues dataset only.
+	Lifecycle   []string         `json:"lifecycle,omitempty"`   // The project lifecycles, e.g. 'production'.
+	Orgs        []string         `json:"orgs,omitempty"`        // The organization IDs, group exports only.
+	Updated     *ExportDateRange `json:"updated,omitempty"`     // The time range the issues were updated in, issues dataset only.
+}
+
+type ExportDateRange struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+}
+
+// ExportIssueRow is a row of the issues dataset with its most common columns, to be used with DecodeExportRows.
+type ExportIssueRow struct {
+	CVE                  string    `csv:"CVE"`
+	CWE                  string    `csv:"CWE"`
+	ExploitMaturity      string    `csv:"EXPLOIT_MATURITY"`
+	FirstIntroduced      time.Time `csv:"FIRST_INTRODUCED"`
+	IssueSeverity        string    `csv:"ISSUE_SEVERITY"`
+	IssueStatus          string    `csv:"ISSUE_STATUS"`
+	IssueStatusIndicator string    `csv:"ISSUE_STATUS_INDICATOR"`
+	IssueType            string    `csv:"ISSUE_TYPE"`
+	IssueURL             string    `csv:"ISSUE_URL"`
+	OrgDisplayName       string    `csv:"ORG_DISPLAY_NAME"`
+	OrgPublicID          string    `csv:"ORG_PUBLIC_ID"`
+	ProblemTitle         string    `csv:"PROBLEM_TITLE"`
+	ProductName          string    `csv:"PRODUCT_NAME"`
+	ProjectName          string    `csv:"PROJECT_NAME"`
+	ProjectPublicID      string    `csv:"PROJECT_PUBLIC_ID"`
+	ProjectURL           string    `csv:"PROJECT_URL"`
+	Score                int       `csv:"SCORE"`
+}
+
+type exportJobRoot struct {
+	ExportJob *ExportJob `json:"data"`
+}
+
+type exportRoot struct {
+	Export *Export `json:"data"`
+}
+
+func (e Export) String() string { return Stringify(e) }
+
+func (e ExportJob) String() string { return Stringify(e) }
+
+func (s *ExportsService) CreateGroupExport(ctx context.Context, groupID string, createRequest *ExportCreateRequest) (*ExportJob, *Response, error) {
+	if groupID == "" {
+		return nil, nil, errors.New("failed to create export: group id must be supplied")
+	}
+	if createRequest == nil {
+		return nil, nil, errors.New("failed to create export: payload must be supplied")
+	}
+
+	return s.create(ctx, fmt.Sprintf("%v/%v", groupsBasePath, groupID), createRequest)
+}
+
+func (s *ExportsService) GetGroupExportJob(ctx context.Context, groupID, exportID string) (*ExportJob, *Response, error) {
+	if groupID == "" {
+		return nil, nil, errors.New("failed to get export job: group id must be supplied")
+	}
+	if exportID == "" {
+		return nil, nil, errors.New("failed to get export job: export id must be supplied")
+	}
+
+	return s.getJob(ctx, fmt.Sprintf("%v/%v", groupsBasePath, groupID), exportID)
+}
+
+func (s *ExportsService) WaitForGroupExport(ctx context.Context, groupID, exportID string, pollInterval time.Duration) (*Export, *Response, error) {
+	if groupID == "" {
+		return nil, nil, errors.New("failed to wait for export: group id must be supplied")
+	}
+	if exportID == "" {
+		return nil, nil, errors.New("failed to wait for export: export id must be supplied")
+	}
+
+	return s.wait(ctx, fmt.Sprintf("%v/%v", groupsBasePath, groupID), exportID, pollInterval)
+}
+
+func (s *ExportsService) GetGroupExport(ctx context.Context, groupID, exportID string) (*Export, *Response, error) {
+	if groupID == "" {
+		return nil, nil, errors.New("failed to get export: group id must be supplied")
+	}
+	if exportID == "" {
+		return nil, nil, errors.New("failed to get export: export id must be supplied")
+	}
+
+	return s.get(ctx, fmt.Sprintf("%v/%v", groupsBasePath, groupID), exportID)
+}
+
+func (s *ExportsService) CreateOrgExport(ctx context.Context, orgID string, createRequest *ExportCreateRequest) (*ExportJob, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to create export: org id must be supplied")
+	}
+	if createRequest == nil {
+		return nil, nil, errors.New("failed to create export: payload must be supplied")
+	}
+
+	return s.create(ctx, fmt.Sprintf("%v/%v", orgsBasePath, orgID), createRequest)
+}
+
+func (s *ExportsService) GetOrgExportJob(ctx context.Context, orgID, exportID string) (*ExportJob, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to get export job: org id must be supplied")
+	}
+	if exportID == "" {
+		return nil, nil, errors.New("failed to get export job: export id must be supplied")
+	}
+
+	return s.getJob(ctx, fmt.Sprintf("%v/%v", orgsBasePath, orgID), exportID)
+}
+
+func (s *ExportsService) WaitForOrgExport(ctx context.Context, orgID, exportID string, pollInterval time.Duration) (*Export, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to wait for export: org id must be supplied")
+	}
+	if exportID == "" {
+		return nil, nil, errors.New("failed to wait for export: export id must be supplied")
+	}
+
+	return s.wait(ctx, fmt.Sprintf("%v/%v", orgsBasePath, orgID), exportID, pollInterval)
+}
+
+func (s *ExportsService) GetOrgExport(ctx context.Context, orgID, exportID string) (*Export, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to get export: org id must be supplied")
+	}
+	if exportID == "" {
+		return nil, nil, errors.New("failed to get export: export id must be supplied")
+	}
+
+	return s.get(ctx, fmt.Sprintf("%v/%v", orgsBasePath, orgID), exportID)
+}
+
+func (s *ExportsService) DownloadResult(ctx context.Context, result ExportResult, w io.Writer) (*Response, error) {
+	if result.URL == "" {
+		return nil, errors.New("failed to download export result: url must be supplied")
+	}
+	if w == nil {
+		return nil, errors.New("failed to download export result: writer must be supplied")
+	}
+
+	// the result URL is pre-signed, so the request must not carry the Snyk API token
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, result.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", s.client.userAgent)
+
+	return s.client.do(ctx, req, w)
+}
+
+// create starts an export at the scope, i.e. 'groups/{group_id}' or 'orgs/{org_id}'.
+func (s *ExportsService) create(ctx context.Context, scopePath string, createRequest *ExportCreateRequest) (*ExportJob, *Response, error) {
+	opts := BaseOptions{Version: exportsAPIVersion}
+
+	path, err := addOptions(scopePath+"/export", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// inline jsonapi create payload to keep create function simple
+	var createRequestJSON struct {
+		Data struct {
+			Attributes struct {
+				Columns []string       `json:"columns,omitempty"`
+				Dataset ExportDataset  `json:"dataset"`
+				Filters *ExportFilters `json:"filters,omitempty"`
+				Formats []string       `json:"formats"`
+			} `json:"attributes"`
+			Type string `json:"type"`
+		} `json:"data"`
+	}
+	createRequestJSON.Data.Attributes.Columns = createRequest.Columns
+	createRequestJSON.Data.Attributes.Dataset = createRequest.Dataset
+	createRequestJSON.Data.Attributes.Filters = createRequest.Filters
+	createRequestJSON.Data.Attributes.Formats = []string{"csv"}
+	createRequestJSON.Data.Type = "resource"
+
+	req, err := s.client.prepareRequest(ctx, http.MethodPost, s.client.restBaseURL, path, createRequestJSON)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(exportJobRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.ExportJob, resp, nil
+}
+
+func (s *ExportsService) getJob(ctx context.Context, scopePath, exportID string) (*ExportJob, *Response, error) {
+	opts := BaseOptions{Version: exportsAPIVersion}
+
+	path, err := addOptions(fmt.Sprintf("%v/jobs/export/%v", scopePath, exportID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, s.client.restBaseURL, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(exportJobRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	// a finished job redirects (303 See Other) to its export, which the http client follows
+	if resp.Request != nil && !strings.Contains(resp.Request.URL.Path, "/jobs/export/") {
+		return &ExportJob{
+			ID:         exportID,
+			Type:       "resource",
+			Attributes: &ExportJobAttributes{Status: ExportStatusFinished},
+		}, resp, nil
+	}
+
+	return root.ExportJob, resp, nil
+}
+
+func (s *ExportsService) wait(ctx context.Context, scopePath, exportID string, pollInterval time.Duration) (*Export, *Response, error) {
+	if pollInterval <= 0 {
+		pollInterval = defaultExportPollInterval
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		job, resp, err := s.getJob(ctx, scopePath, exportID)
+		if err != nil {
+			return nil, resp, err
+		}
+		if job != nil && job.Attributes != nil {
+			switch job.Attributes.Status {
+			case ExportStatusFinished:
+				return s.get(ctx, scopePath, exportID)
+			case ExportStatusError:
+				return nil, resp, fmt.Errorf("export job %v failed", exportID)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, resp, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *ExportsService) get(ctx context.Context, scopePath, exportID string) (*Export, *Response, error) {
+	opts := BaseOptions{Version: exportsAPIVersion}
+
+	path, err := addOptions(fmt.Sprintf("%v/export/%v", scopePath, exportID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, s.client.restBaseURL, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(exportRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Export, resp, nil
+}
+
+// DecodeExportRows returns an iterator over the rows of all CSV chunks of a finished export.
+// Each row is decoded into T, a struct whose fields are mapped to CSV columns by their `csv`
+// tag, e.g. ExportIssueRow. Columns without a matching field are skipped. Supported field
+// types are string, bool, integers, floats and time.Time. Chunks are streamed, so the whole
+// export is never held in memory.
+//
+// Note: This function is experimental and its signature may change in a future release.
+func DecodeExportRows[T any](ctx context.Context, exports ExportsServiceAPI, export *Export) (iter.Seq[T], func() error) {
+	var iterErr error
+
+	if export == nil || export.Attributes == nil {
+		return func(func(T) bool) {}, func() error {
+			return errors.New("failed to decode export rows: export must be supplied")
+		}
+	}
+
+	seq := func(yield func(row T) bool) {
+		for _, result := range export.Attributes.Results {
+			next, err := decodeExportResult(ctx, exports, result, yield)
+			if err != nil {
+				iterErr = err
+				return
+			}
+			if !next {
+				// stop iteration if the consumer stops
+				return
+			}
+		}
+	}
+
+	return seq, func() error { return iterErr }
+}
+
+// decodeExportResult downloads a single chunk and yields its rows. It reports whether the consumer wants more rows.
+func decodeExportResult[T any](ctx context.Context, exports ExportsServiceAPI, result ExportResult, yield func(T) bool) (bool, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pr, pw := io.Pipe()
+	defer func() { _ = pr.Close() }()
+	go func() {
+		_, err := exports.DownloadResult(ctx, result, pw)
+		_ = pw.CloseWithError(err)
+	}()
+
+	reader := csv.NewReader(pr)
+	header, err := reader.Read()
+	if err == io.EOF {
+		return true, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to decode export rows: %w", err)
+	}
+	decoder, err := newCSVRowDecoder[T](header)
+	if err != nil {
+		return false, err
+	}
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return true, nil
+		}
+		if err != nil {
+			return false, fmt.Errorf("failed to decode export rows: %w", err)
+		}
+
+		row, err := decoder.decode(record)
+		if err != nil {
+			return false, err
+		}
+		if !yield(row) {
+			return false, nil
+		}
+	}
+}
+
+// csvRowDecoder decodes CSV records into T, using the column index of each tagged field.
+type csvRowDecoder[T any] struct {
+	fieldColumns map[int]int // field index to column index.
+}
+
+func newCSVRowDecoder[T any](header []string) (*csvRowDecoder[T], error) {
+	rowType := reflect.TypeFor[T]()
+	if rowType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("failed to decode export rows: %v is not a struct", rowType)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		columns[strings.ToUpper(strings.TrimSpace(column))] = i
+	}
+
+	decoder := &csvRowDecoder[T]{fieldColumns: make(map[int]int)}
+	for i := range rowType.NumField() {
+		field := rowType.Field(i)
+		column := field.Tag.Get("csv")
+		if column == "" || column == "-" || !field.IsExported() {
+			continue
+		}
+		if j, found := columns[strings.ToUpper(column)]; found {
+			decoder.fieldColumns[i] = j
+		}
+	}
+	return decoder, nil
+}
+
+func (d *csvRowDecoder[T]) decode(record []string) (T, error) {
+	var row T
+	rowValue := reflect.ValueOf(&row).Elem()
+
+	for fieldIndex, columnIndex := range d.fieldColumns {
+		if columnIndex >= len(record) || record[columnIndex] == "" {
+			continue
+		}
+		field := rowValue.Field(fieldIndex)
+		if err := setCSVField(field, record[columnIndex]); err != nil {
+			return row, fmt.Errorf("failed to decode export rows: field %v: %w", rowValue.Type().Field(fieldIndex).Name, err)
+		}
+	}
+	return row, nil
+}
+
+// exportTimeLayouts are the layouts of timestamps seen in export CSV files.
+var exportTimeLayouts = []string{time.RFC3339Nano, "2006-01-02 15:04:05.999999999", "2006-01-02"}
+
+func setCSVField(field reflect.Value, value string) error {
+	if field.Type() == timeType {
+		for _, layout := range exportTimeLayouts {
+			if t, err := time.Parse(layout, value); err == nil {
+				field.Set(reflect.ValueOf(t))
+				return nil
+			}
+		}
+		return fmt.Errorf("invalid time %q", value)
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(value, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(value, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(value, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported type %v", field.Type())
+	}
+	return nil
+}
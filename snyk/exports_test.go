@@ -0,0 +1,257 @@
+package snyk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExports_CreateGroupExport(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/groups/group-id/export", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]any{
+			"data": map[string]any{
+				"type": "resource",
+				"attributes": map[string]any{
+					"columns": []any{"ISSUE_SEVERITY", "PROBLEM_TITLE"},
+					"dataset": "issues",
+					"formats": []any{"csv"},
+					"filters": map[string]any{
+						"introduced": map[string]any{"from": "2025-01-01T00:00:00Z", "to": "2025-01-31T00:00:00Z"},
+						"orgs":       []any{"org-id"},
+					},
+				},
+			},
+		}, body)
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = fmt.Fprint(w, `{ "data": { "id": "export-id", "type": "resource" } }`)
+	})
+
+	job, _, err := client.Exports.CreateGroupExport(ctx, "group-id", &ExportCreateRequest{
+		Columns: []string{"ISSUE_SEVERITY", "PROBLEM_TITLE"},
+		Dataset: ExportDatasetIssues,
+		Filters: &ExportFilters{
+			Introduced: &ExportDateRange{
+				From: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				To:   time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
+			},
+			Orgs: []string{"org-id"},
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, &ExportJob{ID: "export-id", Type: "resource"}, job)
+}
+
+func TestExports_CreateOrgExport_emptyPayload(t *testing.T) {
+	_, _, err := client.Exports.CreateOrgExport(ctx, "org-id", nil)
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "payload must be supplied")
+}
+
+func TestExports_GetOrgExportJob(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/jobs/export/export-id", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		_, _ = fmt.Fprint(w, `{ "data": { "id": "export-id", "type": "resource", "attributes": { "status": "STARTED" } } }`)
+	})
+
+	job, _, err := client.Exports.GetOrgExportJob(ctx, "org-id", "export-id")
+
+	assert.NoError(t, err)
+	assert.Equal(t, ExportStatusStarted, job.Attributes.Status)
+}
+
+func TestExports_WaitForOrgExport(t *testing.T) {
+	setup()
+	defer teardown()
+
+	polls := 0
+	mux.HandleFunc("/orgs/org-id/jobs/export/export-id", func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		if polls < 2 {
+			_, _ = fmt.Fprint(w, `{ "data": { "id": "export-id", "type": "resource", "attributes": { "status": "PENDING" } } }`)
+			return
+		}
+		http.Redirect(w, r, "/orgs/org-id/export/export-id?version=2024-10-15", http.StatusSeeOther)
+	})
+	mux.HandleFunc("/orgs/org-id/export/export-id", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		_, _ = fmt.Fprint(w, `
+{
+  "data": {
+    "id": "export-id",
+    "type": "export",
+    "attributes": {
+      "dataset": "issues",
+      "row_count": 2,
+      "results": [ { "url": "https://example.com/chunk-1.csv", "file_size": 100, "row_count": 2 } ]
+    }
+  }
+}
+`)
+	})
+
+	export, _, err := client.Exports.WaitForOrgExport(ctx, "org-id", "export-id", time.Millisecond)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, polls)
+	assert.Equal(t, &Export{
+		ID:   "export-id",
+		Type: "export",
+		Attributes: &ExportAttributes{
+			Dataset:  ExportDatasetIssues,
+			Results:  []ExportResult{{FileSize: 100, RowCount: 2, URL: "https://example.com/chunk-1.csv"}},
+			RowCount: 2,
+		},
+	}, export)
+}
+
+func TestExports_WaitForGroupExport_error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/groups/group-id/jobs/export/export-id", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{ "data": { "id": "export-id", "type": "resource", "attributes": { "status": "ERROR" } } }`)
+	})
+
+	_, _, err := client.Exports.WaitForGroupExport(ctx, "group-id", "export-id", time.Millisecond)
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "export job export-id failed")
+}
+
+func TestExports_DownloadResult(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/chunks/chunk-1.csv", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Empty(t, r.Header.Get("Authorization"))
+		_, _ = fmt.Fprint(w, "ISSUE_SEVERITY,PROBLEM_TITLE\nhigh,Prototype Pollution\n")
+	})
+
+	var buf bytes.Buffer
+	_, err := client.Exports.DownloadResult(ctx, ExportResult{URL: server.URL + "/chunks/chunk-1.csv"}, &buf)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "ISSUE_SEVERITY,PROBLEM_TITLE\nhigh,Prototype Pollution\n", buf.String())
+}
+
+func TestDecodeExportRows(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/chunks/chunk-1.csv", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `ISSUE_SEVERITY,PROBLEM_TITLE,SCORE,FIRST_INTRODUCED,UNKNOWN_COLUMN
+high,Prototype Pollution,700,2025-01-02 03:04:05.000,x
+low,"Information Exposure, via logs",,2025-01-03,y
+`)
+	})
+	mux.HandleFunc("/chunks/chunk-2.csv", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, "PROBLEM_TITLE,ISSUE_SEVERITY\nRegular Expression Denial of Service,medium\n")
+	})
+	export := &Export{Attributes: &ExportAttributes{Results: []ExportResult{
+		{URL: server.URL + "/chunks/chunk-1.csv"},
+		{URL: server.URL + "/chunks/chunk-2.csv"},
+	}}}
+
+	var actualRows []ExportIssueRow
+	rows, errFn := DecodeExportRows[ExportIssueRow](ctx, client.Exports, export)
+	for row := range rows {
+		actualRows = append(actualRows, row)
+	}
+
+	assert.NoError(t, errFn())
+	assert.Equal(t, []ExportIssueRow{
+		{
+			FirstIntroduced: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+			IssueSeverity:   "high",
+			ProblemTitle:    "Prototype Pollution",
+			Score:           700,
+		},
+		{
+			FirstIntroduced: time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC),
+			IssueSeverity:   "low",
+			ProblemTitle:    "Information Exposure, via logs",
+		},
+		{
+			IssueSeverity: "medium",
+			ProblemTitle:  "Regular Expression Denial of Service",
+		},
+	}, actualRows)
+}
+
+func TestDecodeExportRows_stopEarly(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/chunks/chunk-1.csv", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, "PROBLEM_TITLE\nfirst\nsecond\n")
+	})
+	mux.HandleFunc("/chunks/chunk-2.csv", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected download of second chunk")
+	})
+	export := &Export{Attributes: &ExportAttributes{Results: []ExportResult{
+		{URL: server.URL + "/chunks/chunk-1.csv"},
+		{URL: server.URL + "/chunks/chunk-2.csv"},
+	}}}
+
+	var titles []string
+	rows, errFn := DecodeExportRows[ExportIssueRow](ctx, client.Exports, export)
+	for row := range rows {
+		titles = append(titles, row.ProblemTitle)
+		break
+	}
+
+	assert.NoError(t, errFn())
+	assert.Equal(t, []string{"first"}, titles)
+}
+
+func TestDecodeExportRows_invalidValue(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/chunks/chunk-1.csv", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, "SCORE\nnot-a-number\n")
+	})
+	export := &Export{Attributes: &ExportAttributes{Results: []ExportResult{{URL: server.URL + "/chunks/chunk-1.csv"}}}}
+
+	rows, errFn := DecodeExportRows[ExportIssueRow](ctx, client.Exports, export)
+	for range rows {
+		t.Error("unexpected row")
+	}
+
+	assert.Error(t, errFn())
+	assert.ErrorContains(t, errFn(), "field Score")
+}
+
+func TestDecodeExportRows_downloadError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/chunks/chunk-1.csv", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+	export := &Export{Attributes: &ExportAttributes{Results: []ExportResult{{URL: server.URL + "/chunks/chunk-1.csv"}}}}
+
+	rows, errFn := DecodeExportRows[ExportIssueRow](ctx, client.Exports, export)
+	for range rows {
+		t.Error("unexpected row")
+	}
+
+	assert.Error(t, errFn())
+}
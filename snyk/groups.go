@@ -38,6 +38,48 @@ type GroupsServiceAPI interface {
 	//
 	// See: https://docs.snyk.io/snyk-api/reference/group#get-groups-group_id
 	Get(ctx context.Context, groupID string) (*Group, *Response, error)
+
+	// ListOrgsInGroup gets a paginated list of all organizations within a group.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/groups#get-groups-group_id-orgs
+	ListOrgsInGroup(ctx context.Context, groupID string, opts *ListOrgsInGroupOptions) ([]Organization, *Response, error)
+
+	// AllOrgsInGroup returns an iterator to paginate over all organizations within a group.
+	//
+	// This method handles the pagination logic internally by calling ListOrgsInGroup for each page.
+	// The return iterated can be used in a for...range loop to easily process all organizations.
+	//
+	// Note: This function is experimental and its signature may change in a future release.
+	AllOrgsInGroup(ctx context.Context, groupID string, opts *ListOrgsInGroupOptions) (iter.Seq2[Organization, *Response], func() error)
+
+	// CreateOrg makes a new organization within a group. If OrganizationCreateRequest.SourceOrgID is set,
+	// then settings will be copied from this organization.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/groups#post-groups-group_id-orgs
+	CreateOrg(ctx context.Context, groupID string, createRequest *OrganizationCreateRequest) (*Organization, *Response, error)
+
+	// DeleteOrg removes an organization from a group.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/groups#delete-groups-group_id-orgs-org_id
+	DeleteOrg(ctx context.Context, groupID, orgID string) (*Response, error)
+
+	// GetIaCSettings provides the Infrastructure as Code settings of a group.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/iac-settings#get-groups-group_id-settings-iac
+	GetIaCSettings(ctx context.Context, groupID string) (*IaCSettings, *Response, error)
+
+	// UpdateIaCSettings changes the Infrastructure as Code settings of a group. Only the non-nil
+	// fields of IaCSettingsUpdateRequest are sent, all other settings remain unchanged.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/iac-settings#patch-groups-group_id-settings-iac
+	UpdateIaCSettings(ctx context.Context, groupID string, updateRequest *IaCSettingsUpdateRequest) (*IaCSettings, *Response, error)
+
+	// GetUsage provides the number of tests consumed by each organization of a group within a time range.
+	// The organizations are listed with AllOrgsInGroup and their tests are counted with
+	// ReportingServiceAPI.GetTestCounts, one request per organization. If the tests of some
+	// organizations cannot be counted, the usage of the other organizations is returned
+	// together with the error.
+	GetUsage(ctx context.Context, groupID string, opts *GroupUsageOptions) (*GroupUsage, error)
 }
 
 // GroupsService handles communication with the group related methods of the Snyk API.
@@ -70,6 +112,38 @@ type ListGroupsOptions struct {
 	ListOptions
 }
 
+type ListOrgsInGroupOptions struct {
+	ListOptions
+	Name string `url:"name,omitempty"` // If set, only return organizations whose name contains this value.
+	Slug string `url:"slug,omitempty"` // If set, only return organizations whose slug exactly matches this value.
+}
+
+type OrganizationCreateRequest struct {
+	Name        string
+	SourceOrgID string // id of the organization to copy settings from.
+}
+
+type GroupUsageOptions struct {
+	From time.Time // Start of the time range (inclusive).
+	To   time.Time // End of the time range (inclusive).
+}
+
+// GroupUsage represents the tests consumed by the organizations of a group.
+type GroupUsage struct {
+	From       time.Time      // Start of the time range (inclusive).
+	Orgs       []OrgTestUsage // The usage of each organization.
+	To         time.Time      // End of the time range (inclusive).
+	TotalTests int            // The number of tests of all organizations.
+}
+
+type OrgTestUsage struct {
+	OrgID        string // The Organization identifier.
+	OrgName      string // The display name of the Organization.
+	PrivateTests int    // The number of tests of private projects.
+	PublicTests  int    // The number of tests of public projects.
+	Tests        int    // The number of all tests.
+}
+
 type groupRoot struct {
 	Group *Group `json:"data,omitempty"`
 }
@@ -81,6 +155,8 @@ type groupsRoot struct {
 
 func (g Group) String() string { return Stringify(g) }
 
+func (u GroupUsage) String() string { return Stringify(u) }
+
 func (s *GroupsService) List(ctx context.Context, opts *ListOptions) ([]Group, *Response, error) {
 	if opts == nil {
 		opts = &ListOptions{}
@@ -146,3 +222,175 @@ func (s *GroupsService) Get(ctx context.Context, groupID string) (*Group, *Respo
 
 	return root.Group, resp, nil
 }
+
+func (s *GroupsService) ListOrgsInGroup(ctx context.Context, groupID string, opts *ListOrgsInGroupOptions) ([]Organization, *Response, error) {
+	if groupID == "" {
+		return nil, nil, errors.New("failed to list orgs in group: group id must be supplied")
+	}
+
+	if opts == nil {
+		opts = &ListOrgsInGroupOptions{}
+	}
+	if opts.Version == "" {
+		opts.Version = groupsAPIVersion
+	}
+
+	path, err := addOptions(fmt.Sprintf("%v/%v/%v", groupsBasePath, groupID, orgsBasePath), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, s.client.restBaseURL, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(orgsRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if l := root.Links; l != nil {
+		resp.Links = l
+	}
+
+	return root.Organizations, resp, nil
+}
+
+func (s *GroupsService) AllOrgsInGroup(ctx context.Context, groupID string, opts *ListOrgsInGroupOptions) (iter.Seq2[Organization, *Response], func() error) {
+	if groupID == "" {
+		return errorPaginator[Organization](errors.New("failed to list orgs in group: group id must be supplied"))
+	}
+
+	if opts == nil {
+		opts = &ListOrgsInGroupOptions{}
+	}
+	if opts.Version == "" {
+		opts.Version = groupsAPIVersion
+	}
+	return newPaginator[Organization](ctx, s.client, s.client.restBaseURL, fmt.Sprintf("%v/%v/%v", groupsBasePath, groupID, orgsBasePath), opts)
+}
+
+func (s *GroupsService) CreateOrg(ctx context.Context, groupID string, createRequest *OrganizationCreateRequest) (*Organization, *Response, error) {
+	if groupID == "" {
+		return nil, nil, errors.New("failed to create org: group id must be supplied")
+	}
+	if createRequest == nil {
+		return nil, nil, errors.New("failed to create org: payload must be supplied")
+	}
+
+	opts := &BaseOptions{Version: groupsAPIVersion}
+	path, err := addOptions(fmt.Sprintf("%v/%v/%v", groupsBasePath, groupID, orgsBasePath), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// inline jsonapi create payload to keep create function simple
+	var createRequestJSON struct {
+		Data struct {
+			Attributes struct {
+				Name        string `json:"name"`
+				SourceOrgID string `json:"source_org_id,omitempty"`
+			} `json:"attributes"`
+			Type string `json:"type"`
+		} `json:"data"`
+	}
+	createRequestJSON.Data.Attributes.Name = createRequest.Name
+	createRequestJSON.Data.Attributes.SourceOrgID = createRequest.SourceOrgID
+	createRequestJSON.Data.Type = "org"
+
+	req, err := s.client.prepareRequest(ctx, http.MethodPost, s.client.restBaseURL, path, createRequestJSON)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(orgRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Organization, resp, nil
+}
+
+func (s *GroupsService) DeleteOrg(ctx context.Context, groupID, orgID string) (*Response, error) {
+	if groupID == "" {
+		return nil, errors.New("failed to delete org: group id must be supplied")
+	}
+	if orgID == "" {
+		return nil, errors.New("failed to delete org: org id must be supplied")
+	}
+
+	opts := &BaseOptions{Version: groupsAPIVersion}
+	path, err := addOptions(fmt.Sprintf("%v/%v/%v/%v", groupsBasePath, groupID, orgsBasePath, orgID), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodDelete, s.client.restBaseURL, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
+
+func (s *GroupsService) GetIaCSettings(ctx context.Context, groupID string) (*IaCSettings, *Response, error) {
+	if groupID == "" {
+		return nil, nil, errors.New("failed to get iac settings: group id must be supplied")
+	}
+
+	return getIaCSettings(ctx, s.client, fmt.Sprintf("%v/%v/settings/iac", groupsBasePath, groupID), groupsAPIVersion)
+}
+
+func (s *GroupsService) UpdateIaCSettings(ctx context.Context, groupID string, updateRequest *IaCSettingsUpdateRequest) (*IaCSettings, *Response, error) {
+	if groupID == "" {
+		return nil, nil, errors.New("failed to update iac settings: group id must be supplied")
+	}
+	if updateRequest == nil {
+		return nil, nil, errors.New("failed to update iac settings: payload must be supplied")
+	}
+
+	return updateIaCSettings(ctx, s.client, fmt.Sprintf("%v/%v/settings/iac", groupsBasePath, groupID), groupsAPIVersion, updateRequest)
+}
+
+func (s *GroupsService) GetUsage(ctx context.Context, groupID string, opts *GroupUsageOptions) (*GroupUsage, error) {
+	if groupID == "" {
+		return nil, errors.New("failed to get group usage: group id must be supplied")
+	}
+	if opts == nil || opts.From.IsZero() || opts.To.IsZero() {
+		return nil, errors.New("failed to get group usage: from and to must be supplied")
+	}
+
+	usage := &GroupUsage{From: opts.From, To: opts.To}
+	// test counts can be grouped by visibility only, so each org needs its own request
+	countsOpts := &ReportingCountsOptions{From: opts.From, To: opts.To, GroupBy: "isPrivate"}
+
+	var errs []error
+	orgs, orgsErr := s.AllOrgsInGroup(ctx, groupID, nil)
+	for org := range orgs {
+		counts, _, err := s.client.Reporting.GetTestCounts(ctx, countsOpts, &ReportingFilters{Orgs: []string{org.ID}})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to get group usage of org %v: %w", org.ID, err))
+			continue
+		}
+
+		orgUsage := OrgTestUsage{OrgID: org.ID}
+		if org.Attributes != nil {
+			orgUsage.OrgName = org.Attributes.Name
+		}
+		for _, count := range counts {
+			orgUsage.Tests += count.Count
+			orgUsage.PrivateTests += count.IsPrivate["true"]
+			orgUsage.PublicTests += count.IsPrivate["false"]
+		}
+
+		usage.Orgs = append(usage.Orgs, orgUsage)
+		usage.TotalTests += orgUsage.Tests
+	}
+	if err := orgsErr(); err != nil {
+		errs = append(errs, fmt.Errorf("failed to get group usage: %w", err))
+	}
+
+	return usage, errors.Join(errs...)
+}
@@ -0,0 +1,308 @@
+package snyk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroups_ListOrgsInGroup(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/groups/group-id/orgs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "test", r.URL.Query().Get("name"))
+		assert.Equal(t, groupsAPIVersion, r.URL.Query().Get("version"))
+		_, _ = fmt.Fprint(w, `
+{
+  "jsonapi": { "version": "1.0" },
+  "data": [
+    {
+      "id": "9a46d918-8764-458c-1234-0987abcd6543",
+      "type": "org",
+      "attributes": {
+        "group_id": "group-id",
+        "is_personal": false,
+        "name": "Test Org",
+        "slug": "test-org"
+      }
+    }
+  ],
+  "links": {}
+}
+`)
+	})
+	expectedOrgs := []Organization{{
+		ID:   "9a46d918-8764-458c-1234-0987abcd6543",
+		Type: "org",
+		Attributes: &OrganizationAttributes{
+			GroupID: "group-id",
+			Name:    "Test Org",
+			Slug:    "test-org",
+		},
+	}}
+
+	actualOrgs, _, err := client.Groups.ListOrgsInGroup(ctx, "group-id", &ListOrgsInGroupOptions{Name: "test"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedOrgs, actualOrgs)
+}
+
+func TestGroups_ListOrgsInGroup_emptyGroupID(t *testing.T) {
+	_, _, err := client.Groups.ListOrgsInGroup(ctx, "", nil)
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "group id must be supplied")
+}
+
+func TestGroups_AllOrgsInGroup(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/groups/group-id/orgs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "test-org", r.URL.Query().Get("slug"))
+		if r.URL.Query().Get("starting_after") == "" {
+			_, _ = fmt.Fprint(w, `
+{
+  "data": [ { "id": "org-1", "type": "org" } ],
+  "links": { "next": "/groups/group-id/orgs?slug=test-org&starting_after=cursor-1&version=2025-11-05" }
+}
+`)
+			return
+		}
+		assert.Equal(t, "cursor-1", r.URL.Query().Get("starting_after"))
+		_, _ = fmt.Fprint(w, `{ "data": [ { "id": "org-2", "type": "org" } ], "links": {} }`)
+	})
+
+	var actualOrgIDs []string
+	orgs, iterErr := client.Groups.AllOrgsInGroup(ctx, "group-id", &ListOrgsInGroupOptions{Slug: "test-org"})
+	for org := range orgs {
+		actualOrgIDs = append(actualOrgIDs, org.ID)
+	}
+
+	assert.NoError(t, iterErr())
+	assert.Equal(t, []string{"org-1", "org-2"}, actualOrgIDs)
+}
+
+func TestGroups_CreateOrg(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/groups/group-id/orgs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, map[string]any{
+			"data": map[string]any{
+				"attributes": map[string]any{"name": "Test Org", "source_org_id": "source-org-id"},
+				"type":       "org",
+			},
+		}, body)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `
+{
+  "jsonapi": { "version": "1.0" },
+  "data": {
+    "id": "9a46d918-8764-458c-1234-0987abcd6543",
+    "type": "org",
+    "attributes": { "group_id": "group-id", "is_personal": false, "name": "Test Org", "slug": "test-org" }
+  }
+}
+`)
+	})
+	expectedOrg := &Organization{
+		ID:   "9a46d918-8764-458c-1234-0987abcd6543",
+		Type: "org",
+		Attributes: &OrganizationAttributes{
+			GroupID: "group-id",
+			Name:    "Test Org",
+			Slug:    "test-org",
+		},
+	}
+
+	actualOrg, _, err := client.Groups.CreateOrg(ctx, "group-id", &OrganizationCreateRequest{Name: "Test Org", SourceOrgID: "source-org-id"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedOrg, actualOrg)
+}
+
+func TestGroups_CreateOrg_emptyPayload(t *testing.T) {
+	_, _, err := client.Groups.CreateOrg(ctx, "group-id", nil)
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "payload must be supplied")
+}
+
+func TestGroups_DeleteOrg(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/groups/group-id/orgs/org-id", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Groups.DeleteOrg(ctx, "group-id", "org-id")
+
+	assert.NoError(t, err)
+}
+
+func TestGroups_DeleteOrg_emptyOrgID(t *testing.T) {
+	_, err := client.Groups.DeleteOrg(ctx, "group-id", "")
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "org id must be supplied")
+}
+
+func TestGroups_GetIaCSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/groups/group-id/settings/iac", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		_, _ = fmt.Fprint(w, `{ "data": { "type": "iac_settings", "attributes": { "custom_rules": { "is_enabled": false } } } }`)
+	})
+	expectedSettings := &IaCSettings{
+		Type:       "iac_settings",
+		Attributes: &IaCSettingsAttributes{CustomRules: &IaCCustomRules{}},
+	}
+
+	actualSettings, _, err := client.Groups.GetIaCSettings(ctx, "group-id")
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedSettings, actualSettings)
+}
+
+func TestGroups_UpdateIaCSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/groups/group-id/settings/iac", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]any{
+			"data": map[string]any{
+				"type":       "iac_settings",
+				"attributes": map[string]any{"custom_rules": map[string]any{"is_enabled": true}},
+			},
+		}, body)
+		_, _ = fmt.Fprint(w, `{ "data": { "type": "iac_settings", "attributes": { "custom_rules": { "is_enabled": true } } } }`)
+	})
+
+	isEnabled := true
+	settings, _, err := client.Groups.UpdateIaCSettings(ctx, "group-id", &IaCSettingsUpdateRequest{IsEnabled: &isEnabled})
+
+	assert.NoError(t, err)
+	assert.True(t, settings.Attributes.CustomRules.IsEnabled)
+}
+
+func TestGroups_UpdateIaCSettings_emptyGroupID(t *testing.T) {
+	_, _, err := client.Groups.UpdateIaCSettings(ctx, "", &IaCSettingsUpdateRequest{})
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "group id must be supplied")
+}
+
+func TestGroups_GetUsage(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/groups/group-id/orgs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		_, _ = fmt.Fprint(w, `
+{
+  "data": [
+    { "id": "org-1", "type": "org", "attributes": { "name": "First Org" } },
+    { "id": "org-2", "type": "org", "attributes": { "name": "Second Org" } }
+  ],
+  "links": {}
+}
+`)
+	})
+	mux.HandleFunc("/reporting/counts/tests", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "2025-01-01", r.URL.Query().Get("from"))
+		assert.Equal(t, "2025-01-31", r.URL.Query().Get("to"))
+		var body struct {
+			Filters ReportingFilters `json:"filters"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		switch body.Filters.Orgs[0] {
+		case "org-1":
+			_, _ = fmt.Fprint(w, `{ "results": [ { "count": 10, "isPrivate": { "true": 7, "false": 3 } } ] }`)
+		default:
+			_, _ = fmt.Fprint(w, `{ "results": [ { "count": 5, "isPrivate": { "true": 5, "false": 0 } } ] }`)
+		}
+	})
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+	expectedUsage := &GroupUsage{
+		From: from,
+		Orgs: []OrgTestUsage{
+			{OrgID: "org-1", OrgName: "First Org", PrivateTests: 7, PublicTests: 3, Tests: 10},
+			{OrgID: "org-2", OrgName: "Second Org", PrivateTests: 5, Tests: 5},
+		},
+		To:         to,
+		TotalTests: 15,
+	}
+
+	actualUsage, err := client.Groups.GetUsage(ctx, "group-id", &GroupUsageOptions{From: from, To: to})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedUsage, actualUsage)
+}
+
+func TestGroups_GetUsage_partialFailure(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/groups/group-id/orgs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		_, _ = fmt.Fprint(w, `
+{
+  "data": [
+    { "id": "org-1", "type": "org", "attributes": { "name": "First Org" } },
+    { "id": "org-2", "type": "org", "attributes": { "name": "Second Org" } }
+  ],
+  "links": {}
+}
+`)
+	})
+	mux.HandleFunc("/reporting/counts/tests", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Filters ReportingFilters `json:"filters"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		if body.Filters.Orgs[0] == "org-1" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{ "results": [ { "count": 5, "isPrivate": { "true": 5, "false": 0 } } ] }`)
+	})
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+	expectedUsage := &GroupUsage{
+		From:       from,
+		Orgs:       []OrgTestUsage{{OrgID: "org-2", OrgName: "Second Org", PrivateTests: 5, Tests: 5}},
+		To:         to,
+		TotalTests: 5,
+	}
+
+	actualUsage, err := client.Groups.GetUsage(ctx, "group-id", &GroupUsageOptions{From: from, To: to})
+
+	assert.ErrorContains(t, err, "failed to get group usage of org org-1")
+	assert.Equal(t, expectedUsage, actualUsage)
+}
+
+func TestGroups_GetUsage_emptyTimeRange(t *testing.T) {
+	_, err := client.Groups.GetUsage(ctx, "group-id", nil)
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "from and to must be supplied")
+}
@@ -41,6 +41,39 @@ type OrgsServiceAPI interface {
 	//
 	// See: https://docs.snyk.io/snyk-api/reference/orgs#patch-orgs-org_id
 	Update(ctx context.Context, orgID string, updateRequest *OrganizationUpdateRequest) (*Organization, *Response, error)
+
+	// GetSASTSettings provides the Snyk Code settings of an organization.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/sast-settings#get-orgs-org_id-settings-sast
+	GetSASTSettings(ctx context.Context, orgID string) (*SASTSettings, *Response, error)
+
+	// UpdateSASTSettings changes the Snyk Code settings of an organization. Only the non-nil
+	// fields of SASTSettingsUpdateRequest are sent, all other settings remain unchanged.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/sast-settings#patch-orgs-org_id-settings-sast
+	UpdateSASTSettings(ctx context.Context, orgID string, updateRequest *SASTSettingsUpdateRequest) (*SASTSettings, *Response, error)
+
+	// GetIaCSettings provides the Infrastructure as Code settings of an organization.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/iac-settings#get-orgs-org_id-settings-iac
+	GetIaCSettings(ctx context.Context, orgID string) (*IaCSettings, *Response, error)
+
+	// UpdateIaCSettings changes the Infrastructure as Code settings of an organization. Only the non-nil
+	// fields of IaCSettingsUpdateRequest are sent, all other settings remain unchanged.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/iac-settings#patch-orgs-org_id-settings-iac
+	UpdateIaCSettings(ctx context.Context, orgID string, updateRequest *IaCSettingsUpdateRequest) (*IaCSettings, *Response, error)
+
+	// GetOpenSourceSettings provides the Snyk Open Source settings of an organization, e.g. reachability.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/opensource-settings#get-orgs-org_id-settings-opensource
+	GetOpenSourceSettings(ctx context.Context, orgID string) (*OpenSourceSettings, *Response, error)
+
+	// UpdateOpenSourceSettings changes the Snyk Open Source settings of an organization. Only the non-nil
+	// fields of OpenSourceSettingsUpdateRequest are sent, all other settings remain unchanged.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/opensource-settings#patch-orgs-org_id-settings-opensource
+	UpdateOpenSourceSettings(ctx context.Context, orgID string, updateRequest *OpenSourceSettingsUpdateRequest) (*OpenSourceSettings, *Response, error)
 }
 
 // OrgsService handles communication with the org related methods of the Snyk API.
@@ -86,6 +119,80 @@ type OrganizationUpdateRequest struct {
 	Name string
 }
 
+// SASTSettings represents the Snyk Code settings of an organization.
+//
+// See: https://docs.snyk.io/scan-with-snyk/snyk-code/configure-snyk-code
+type SASTSettings struct {
+	ID         string                  `json:"id"`                   // The SASTSettings identifier, same as the Organization identifier.
+	Type       string                  `json:"type"`                 // The resource type `sast_settings`.
+	Attributes *SASTSettingsAttributes `json:"attributes,omitempty"` // The SASTSettings resource data.
+}
+
+type SASTSettingsAttributes struct {
+	AutofixEnabled bool `json:"autofix_enabled"` // Whether Snyk Code fixes are suggested automatically.
+	SASTEnabled    bool `json:"sast_enabled"`    // Whether Snyk Code is enabled.
+}
+
+type SASTSettingsUpdateRequest struct {
+	AutofixEnabled *bool
+	SASTEnabled    *bool
+}
+
+// IaCSettings represents the Infrastructure as Code settings of an organization or a group.
+//
+// See: https://docs.snyk.io/scan-with-snyk/snyk-iac/current-iac-custom-rules
+type IaCSettings struct {
+	ID         string                 `json:"id,omitempty"`         // The IaCSettings identifier.
+	Type       string                 `json:"type"`                 // The resource type `iac_settings`.
+	Attributes *IaCSettingsAttributes `json:"attributes,omitempty"` // The IaCSettings resource data.
+}
+
+type IaCSettingsAttributes struct {
+	CustomRules *IaCCustomRules   `json:"custom_rules,omitempty"` // The custom rules configuration.
+	Parent      *IaCSettingsScope `json:"parent,omitempty"`       // The settings of the parent group, only set for organizations.
+	Updated     string            `json:"updated,omitempty"`      // The time the settings were last modified.
+}
+
+type IaCSettingsScope struct {
+	CustomRules *IaCCustomRules `json:"custom_rules,omitempty"`
+	Updated     string          `json:"updated,omitempty"`
+}
+
+type IaCCustomRules struct {
+	InheritFromParent string `json:"inherit_from_parent,omitempty"` // If set, e.g. to 'group', the rules are inherited from the parent.
+	IsEnabled         bool   `json:"is_enabled"`                    // Whether custom rules are enabled.
+	OCIRegistryTag    string `json:"oci_registry_tag,omitempty"`    // The tag of the OCI artifact with the rules bundle.
+	OCIRegistryURL    string `json:"oci_registry_url,omitempty"`    // The URL of the OCI registry with the rules bundle.
+}
+
+type IaCSettingsUpdateRequest struct {
+	InheritFromParent *string // Use "group" to inherit the rules from the parent group, and "" to stop inheriting (organizations only).
+	IsEnabled         *bool
+	OCIRegistryTag    *string
+	OCIRegistryURL    *string
+}
+
+// OpenSourceSettings represents the Snyk Open Source settings of an organization.
+//
+// See: https://docs.snyk.io/manage-risk/prioritize-issues-for-fixing/reachability-analysis
+type OpenSourceSettings struct {
+	ID         string                        `json:"id,omitempty"`         // The OpenSourceSettings identifier.
+	Type       string                        `json:"type"`                 // The resource type `opensource_settings`.
+	Attributes *OpenSourceSettingsAttributes `json:"attributes,omitempty"` // The OpenSourceSettings resource data.
+}
+
+type OpenSourceSettingsAttributes struct {
+	Reachability *OpenSourceReachability `json:"reachability,omitempty"` // The reachability analysis configuration.
+}
+
+type OpenSourceReachability struct {
+	Enabled bool `json:"enabled"` // Whether reachability analysis is enabled.
+}
+
+type OpenSourceSettingsUpdateRequest struct {
+	ReachabilityEnabled *bool
+}
+
 type orgRoot struct {
 	Organization *Organization `json:"data,omitempty"`
 }
@@ -95,8 +202,26 @@ type orgsRoot struct {
 	Links         *PaginatedLinks `json:"links,omitempty"`
 }
 
+type sastSettingsRoot struct {
+	SASTSettings *SASTSettings `json:"data"`
+}
+
+type openSourceSettingsRoot struct {
+	OpenSourceSettings *OpenSourceSettings `json:"data"`
+}
+
+type iacSettingsRoot struct {
+	IaCSettings *IaCSettings `json:"data"`
+}
+
 func (o Organization) String() string { return Stringify(o) }
 
+func (s SASTSettings) String() string { return Stringify(s) }
+
+func (s IaCSettings) String() string { return Stringify(s) }
+
+func (s OpenSourceSettings) String() string { return Stringify(s) }
+
 func (s *OrgsService) ListAccessibleOrgs(ctx context.Context, opts *ListOrganizationOptions) ([]Organization, *Response, error) {
 	if opts == nil {
 		opts = &ListOrganizationOptions{}
@@ -207,3 +332,225 @@ func (s *OrgsService) Update(ctx context.Context, orgID string, updateRequest *O
 
 	return root.Organization, resp, nil
 }
+
+func (s *OrgsService) GetSASTSettings(ctx context.Context, orgID string) (*SASTSettings, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to get sast settings: org id must be supplied")
+	}
+
+	opts := &BaseOptions{Version: orgsAPIVersion}
+	path, err := addOptions(fmt.Sprintf("%v/%v/settings/sast", orgsBasePath, orgID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, s.client.restBaseURL, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(sastSettingsRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.SASTSettings, resp, nil
+}
+
+func (s *OrgsService) UpdateSASTSettings(ctx context.Context, orgID string, updateRequest *SASTSettingsUpdateRequest) (*SASTSettings, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to update sast settings: org id must be supplied")
+	}
+	if updateRequest == nil {
+		return nil, nil, errors.New("failed to update sast settings: payload must be supplied")
+	}
+
+	opts := &BaseOptions{Version: orgsAPIVersion}
+	path, err := addOptions(fmt.Sprintf("%v/%v/settings/sast", orgsBasePath, orgID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// inline jsonapi update payload to keep update function simple
+	var updateRequestJSON struct {
+		Data struct {
+			Attributes struct {
+				AutofixEnabled *bool `json:"autofix_enabled,omitempty"`
+				SASTEnabled    *bool `json:"sast_enabled,omitempty"`
+			} `json:"attributes"`
+			ID   string `json:"id"`
+			Type string `json:"type"`
+		} `json:"data"`
+	}
+	updateRequestJSON.Data.Attributes.AutofixEnabled = updateRequest.AutofixEnabled
+	updateRequestJSON.Data.Attributes.SASTEnabled = updateRequest.SASTEnabled
+	updateRequestJSON.Data.ID = orgID
+	updateRequestJSON.Data.Type = "sast_settings"
+
+	req, err := s.client.prepareRequest(ctx, http.MethodPatch, s.client.restBaseURL, path, updateRequestJSON)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(sastSettingsRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.SASTSettings, resp, nil
+}
+
+func (s *OrgsService) GetIaCSettings(ctx context.Context, orgID string) (*IaCSettings, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to get iac settings: org id must be supplied")
+	}
+
+	return getIaCSettings(ctx, s.client, fmt.Sprintf("%v/%v/settings/iac", orgsBasePath, orgID), orgsAPIVersion)
+}
+
+func (s *OrgsService) UpdateIaCSettings(ctx context.Context, orgID string, updateRequest *IaCSettingsUpdateRequest) (*IaCSettings, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to update iac settings: org id must be supplied")
+	}
+	if updateRequest == nil {
+		return nil, nil, errors.New("failed to update iac settings: payload must be supplied")
+	}
+
+	return updateIaCSettings(ctx, s.client, fmt.Sprintf("%v/%v/settings/iac", orgsBasePath, orgID), orgsAPIVersion, updateRequest)
+}
+
+func (s *OrgsService) GetOpenSourceSettings(ctx context.Context, orgID string) (*OpenSourceSettings, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to get open source settings: org id must be supplied")
+	}
+
+	opts := &BaseOptions{Version: orgsAPIVersion}
+	path, err := addOptions(fmt.Sprintf("%v/%v/settings/opensource", orgsBasePath, orgID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, s.client.restBaseURL, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(openSourceSettingsRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.OpenSourceSettings, resp, nil
+}
+
+func (s *OrgsService) UpdateOpenSourceSettings(ctx context.Context, orgID string, updateRequest *OpenSourceSettingsUpdateRequest) (*OpenSourceSettings, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to update open source settings: org id must be supplied")
+	}
+	if updateRequest == nil {
+		return nil, nil, errors.New("failed to update open source settings: payload must be supplied")
+	}
+
+	opts := &BaseOptions{Version: orgsAPIVersion}
+	path, err := addOptions(fmt.Sprintf("%v/%v/settings/opensource", orgsBasePath, orgID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// inline jsonapi update payload to keep update function simple
+	type reachability struct {
+		Enabled *bool `json:"enabled,omitempty"`
+	}
+	var updateRequestJSON struct {
+		Data struct {
+			Attributes struct {
+				Reachability *reachability `json:"reachability,omitempty"`
+			} `json:"attributes"`
+			Type string `json:"type"`
+		} `json:"data"`
+	}
+	if updateRequest.ReachabilityEnabled != nil {
+		updateRequestJSON.Data.Attributes.Reachability = &reachability{Enabled: updateRequest.ReachabilityEnabled}
+	}
+	updateRequestJSON.Data.Type = "opensource_settings"
+
+	req, err := s.client.prepareRequest(ctx, http.MethodPatch, s.client.restBaseURL, path, updateRequestJSON)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(openSourceSettingsRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.OpenSourceSettings, resp, nil
+}
+
+// getIaCSettings fetches the IaC settings from the given org or group settings endpoint.
+func getIaCSettings(ctx context.Context, client *Client, endpointURL, version string) (*IaCSettings, *Response, error) {
+	opts := &BaseOptions{Version: version}
+	path, err := addOptions(endpointURL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := client.prepareRequest(ctx, http.MethodGet, client.restBaseURL, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(iacSettingsRoot)
+	resp, err := client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.IaCSettings, resp, nil
+}
+
+// updateIaCSettings patches the IaC settings at the given org or group settings endpoint.
+func updateIaCSettings(ctx context.Context, client *Client, endpointURL, version string, updateRequest *IaCSettingsUpdateRequest) (*IaCSettings, *Response, error) {
+	opts := &BaseOptions{Version: version}
+	path, err := addOptions(endpointURL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// inline jsonapi update payload to keep update function simple
+	var updateRequestJSON struct {
+		Data struct {
+			Attributes struct {
+				CustomRules struct {
+					InheritFromParent *string `json:"inherit_from_parent,omitempty"`
+					IsEnabled         *bool   `json:"is_enabled,omitempty"`
+					OCIRegistryTag    *string `json:"oci_registry_tag,omitempty"`
+					OCIRegistryURL    *string `json:"oci_registry_url,omitempty"`
+				} `json:"custom_rules"`
+			} `json:"attributes"`
+			Type string `json:"type"`
+		} `json:"data"`
+	}
+	updateRequestJSON.Data.Attributes.CustomRules.InheritFromParent = updateRequest.InheritFromParent
+	updateRequestJSON.Data.Attributes.CustomRules.IsEnabled = updateRequest.IsEnabled
+	updateRequestJSON.Data.Attributes.CustomRules.OCIRegistryTag = updateRequest.OCIRegistryTag
+	updateRequestJSON.Data.Attributes.CustomRules.OCIRegistryURL = updateRequest.OCIRegistryURL
+	updateRequestJSON.Data.Type = "iac_settings"
+
+	req, err := client.prepareRequest(ctx, http.MethodPatch, client.restBaseURL, path, updateRequestJSON)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(iacSettingsRoot)
+	resp, err := client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.IaCSettings, resp, nil
+}
@@ -0,0 +1,194 @@
+package snyk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrgs_GetSASTSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/settings/sast", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		_, _ = fmt.Fprint(w, `{ "data": { "id": "org-id", "type": "sast_settings", "attributes": { "sast_enabled": true, "autofix_enabled": false } } }`)
+	})
+	expectedSettings := &SASTSettings{
+		ID:         "org-id",
+		Type:       "sast_settings",
+		Attributes: &SASTSettingsAttributes{SASTEnabled: true},
+	}
+
+	actualSettings, _, err := client.Orgs.GetSASTSettings(ctx, "org-id")
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedSettings, actualSettings)
+}
+
+func TestOrgs_GetSASTSettings_emptyOrgID(t *testing.T) {
+	_, _, err := client.Orgs.GetSASTSettings(ctx, "")
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "org id must be supplied")
+}
+
+func TestOrgs_UpdateSASTSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/settings/sast", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]any{
+			"data": map[string]any{"id": "org-id", "type": "sast_settings", "attributes": map[string]any{"sast_enabled": false}},
+		}, body)
+		_, _ = fmt.Fprint(w, `{ "data": { "id": "org-id", "type": "sast_settings", "attributes": { "sast_enabled": false, "autofix_enabled": true } } }`)
+	})
+
+	sastEnabled := false
+	settings, _, err := client.Orgs.UpdateSASTSettings(ctx, "org-id", &SASTSettingsUpdateRequest{SASTEnabled: &sastEnabled})
+
+	assert.NoError(t, err)
+	assert.False(t, settings.Attributes.SASTEnabled)
+	assert.True(t, settings.Attributes.AutofixEnabled)
+}
+
+func TestOrgs_UpdateSASTSettings_emptyPayload(t *testing.T) {
+	_, _, err := client.Orgs.UpdateSASTSettings(ctx, "org-id", nil)
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "payload must be supplied")
+}
+
+func TestOrgs_GetOpenSourceSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/settings/opensource", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		_, _ = fmt.Fprint(w, `{ "data": { "id": "org-id", "type": "opensource_settings", "attributes": { "reachability": { "enabled": true } } } }`)
+	})
+	expectedSettings := &OpenSourceSettings{
+		ID:         "org-id",
+		Type:       "opensource_settings",
+		Attributes: &OpenSourceSettingsAttributes{Reachability: &OpenSourceReachability{Enabled: true}},
+	}
+
+	actualSettings, _, err := client.Orgs.GetOpenSourceSettings(ctx, "org-id")
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedSettings, actualSettings)
+}
+
+func TestOrgs_GetOpenSourceSettings_emptyOrgID(t *testing.T) {
+	_, _, err := client.Orgs.GetOpenSourceSettings(ctx, "")
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "org id must be supplied")
+}
+
+func TestOrgs_UpdateOpenSourceSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/settings/opensource", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]any{
+			"data": map[string]any{"type": "opensource_settings", "attributes": map[string]any{"reachability": map[string]any{"enabled": false}}},
+		}, body)
+		_, _ = fmt.Fprint(w, `{ "data": { "id": "org-id", "type": "opensource_settings", "attributes": { "reachability": { "enabled": false } } } }`)
+	})
+
+	reachabilityEnabled := false
+	settings, _, err := client.Orgs.UpdateOpenSourceSettings(ctx, "org-id", &OpenSourceSettingsUpdateRequest{ReachabilityEnabled: &reachabilityEnabled})
+
+	assert.NoError(t, err)
+	assert.False(t, settings.Attributes.Reachability.Enabled)
+}
+
+func TestOrgs_UpdateOpenSourceSettings_emptyPayload(t *testing.T) {
+	_, _, err := client.Orgs.UpdateOpenSourceSettings(ctx, "org-id", nil)
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "payload must be supplied")
+}
+
+func TestOrgs_GetIaCSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/settings/iac", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		_, _ = fmt.Fprint(w, `
+{
+  "data": {
+    "type": "iac_settings",
+    "attributes": {
+      "custom_rules": { "inherit_from_parent": "group", "is_enabled": true },
+      "parent": {
+        "custom_rules": {
+          "is_enabled": true,
+          "oci_registry_tag": "latest",
+          "oci_registry_url": "https://registry-1.docker.io/org/rules"
+        }
+      },
+      "updated": "2025-01-02T03:04:05Z"
+    }
+  }
+}
+`)
+	})
+	expectedSettings := &IaCSettings{
+		Type: "iac_settings",
+		Attributes: &IaCSettingsAttributes{
+			CustomRules: &IaCCustomRules{InheritFromParent: "group", IsEnabled: true},
+			Parent: &IaCSettingsScope{
+				CustomRules: &IaCCustomRules{
+					IsEnabled:      true,
+					OCIRegistryTag: "latest",
+					OCIRegistryURL: "https://registry-1.docker.io/org/rules",
+				},
+			},
+			Updated: "2025-01-02T03:04:05Z",
+		},
+	}
+
+	actualSettings, _, err := client.Orgs.GetIaCSettings(ctx, "org-id")
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedSettings, actualSettings)
+}
+
+func TestOrgs_UpdateIaCSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/settings/iac", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]any{
+			"data": map[string]any{
+				"type":       "iac_settings",
+				"attributes": map[string]any{"custom_rules": map[string]any{"inherit_from_parent": "", "oci_registry_tag": "v2"}},
+			},
+		}, body)
+		_, _ = fmt.Fprint(w, `{ "data": { "type": "iac_settings", "attributes": { "custom_rules": { "is_enabled": true, "oci_registry_tag": "v2" } } } }`)
+	})
+
+	inheritFromParent, ociRegistryTag := "", "v2"
+	settings, _, err := client.Orgs.UpdateIaCSettings(ctx, "org-id", &IaCSettingsUpdateRequest{
+		InheritFromParent: &inheritFromParent,
+		OCIRegistryTag:    &ociRegistryTag,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "v2", settings.Attributes.CustomRules.OCIRegistryTag)
+}
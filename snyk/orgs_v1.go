@@ -1,10 +1,16 @@
 package snyk
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"iter"
 	"net/http"
+	"net/url"
+	"slices"
+	"strings"
 )
 
 const orgV1BasePath = "org"
@@ -19,12 +25,58 @@ type OrgsServiceV1API interface {
 	// Create makes a new organization with given payload.
 	//
 	// See: https://docs.snyk.io/snyk-api/reference/organizations-v1#post-org
+	//
+	// Deprecated: Use GroupsServiceAPI.CreateOrg instead.
 	Create(ctx context.Context, createRequest *OrganizationV1CreateRequest) (*OrganizationV1, *Response, error)
 
 	// Delete removes an organization identified by id.
 	//
 	// See: https://docs.snyk.io/snyk-api/reference/organizations-v1#delete-org-orgid
+	//
+	// Deprecated: Use GroupsServiceAPI.DeleteOrg instead.
 	Delete(ctx context.Context, orgID string) (*Response, error)
+
+	// GetNotificationSettings provides the notification settings of an organization.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/organizations-v1#get-org-orgid-notification-settings
+	GetNotificationSettings(ctx context.Context, orgID string) (*NotificationSettings, *Response, error)
+
+	// UpdateNotificationSettings changes the notification settings of an organization. Only the non-nil
+	// settings of NotificationSettingsUpdateRequest are changed.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/organizations-v1#put-org-orgid-notification-settings
+	UpdateNotificationSettings(ctx context.Context, orgID string, updateRequest *NotificationSettingsUpdateRequest) (*NotificationSettings, *Response, error)
+
+	// ListEntitlements provides the product features of an organization, whether they are enabled
+	// and their quota, if any.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/entitlements-v1#get-org-orgid-entitlements
+	ListEntitlements(ctx context.Context, orgID string) (Entitlements, *Response, error)
+
+	// GetEntitlement provides a single product feature of an organization.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/entitlements-v1#get-org-orgid-entitlement-entitlementkey
+	GetEntitlement(ctx context.Context, orgID string, key EntitlementKey) (*Entitlement, *Response, error)
+
+	// ListDependencies gets a page of the dependencies used by the projects of an organization matching filters.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/dependencies-v1#post-org-orgid-dependencies
+	ListDependencies(ctx context.Context, orgID string, opts *ListDependenciesOptions, filters *DependencyFilters) ([]Dependency, *Response, error)
+
+	// AllDependencies returns an iterator to paginate over all dependencies used by the projects of
+	// an organization matching filters.
+	//
+	// This method handles the pagination logic internally by calling ListDependencies for each page.
+	// The return iterated can be used in a for...range loop to easily process all dependencies.
+	//
+	// Note: This function is experimental and its signature may change in a future release.
+	AllDependencies(ctx context.Context, orgID string, opts *ListDependenciesOptions, filters *DependencyFilters) (iter.Seq2[Dependency, *Response], func() error)
+
+	// ListLicenses provides the licenses of the dependencies used by the projects of an organization
+	// matching filters. Use GroupLicensesByFamily to aggregate the licenses, e.g. all GPL versions.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/licenses-v1#post-org-orgid-licenses
+	ListLicenses(ctx context.Context, orgID string, opts *ListLicensesOptions, filters *LicenseFilters) ([]License, *Response, error)
 }
 
 // OrgsServiceV1 handles communication with the org related methods of the Snyk V1 API.
@@ -53,8 +105,98 @@ type OrganizationV1CreateRequest struct {
 	SourceOrgID string `json:"sourceOrgId,omitempty"` // id of the organization to copy settings from.
 }
 
+// NotificationSettings represents the email notification settings of an organization.
+type NotificationSettings struct {
+	NewIssuesRemediations *IssuesNotificationSetting `json:"new-issues-remediations,omitempty"` // Notifications about new issues and remediations.
+	ProjectImported       *NotificationSetting       `json:"project-imported,omitempty"`        // Notifications about imported projects.
+	TestLimit             *NotificationSetting       `json:"test-limit,omitempty"`              // Notifications about reaching the test limit.
+	WeeklyReport          *NotificationSetting       `json:"weekly-report,omitempty"`           // The weekly report.
+}
+
+type NotificationSetting struct {
+	Enabled   bool `json:"enabled"`
+	Inherited bool `json:"inherited,omitempty"` // Whether the setting is inherited from the group.
+}
+
+type IssuesNotificationSetting struct {
+	Enabled       bool   `json:"enabled"`
+	Inherited     bool   `json:"inherited,omitempty"`     // Whether the setting is inherited from the group.
+	IssueSeverity string `json:"issueSeverity,omitempty"` // The minimum severity to notify about, e.g. 'high'.
+	IssueType     string `json:"issueType,omitempty"`     // The issue type to notify about, e.g. 'vuln' or 'all'.
+}
+
+type NotificationSettingsUpdateRequest struct {
+	NewIssuesRemediations *IssuesNotificationSettingUpdate `json:"new-issues-remediations,omitempty"`
+	ProjectImported       *NotificationSettingUpdate       `json:"project-imported,omitempty"`
+	TestLimit             *NotificationSettingUpdate       `json:"test-limit,omitempty"`
+	WeeklyReport          *NotificationSettingUpdate       `json:"weekly-report,omitempty"`
+}
+
+type NotificationSettingUpdate struct {
+	Enabled bool `json:"enabled"`
+}
+
+type IssuesNotificationSettingUpdate struct {
+	Enabled       bool   `json:"enabled"`
+	IssueSeverity string `json:"issueSeverity,omitempty"`
+	IssueType     string `json:"issueType,omitempty"`
+}
+
+// EntitlementKey defines a Snyk product feature an organization may be entitled to.
+type EntitlementKey string
+
+const (
+	EntitlementKeyAPI                  EntitlementKey = "api"
+	EntitlementKeyDockerfileFromSCM    EntitlementKey = "dockerfileFromScm"
+	EntitlementKeyFullVulnDB           EntitlementKey = "fullVulnDB"
+	EntitlementKeyInfrastructureAsCode EntitlementKey = "infrastructureAsCode"
+	EntitlementKeyLicenses             EntitlementKey = "licenses"
+	EntitlementKeyReports              EntitlementKey = "reports"
+	EntitlementKeySnykCode             EntitlementKey = "snykCode"
+)
+
+// Entitlements represents the product features of an organization.
+// Keys unknown to this SDK are kept, so the result is complete for newer Snyk products.
+type Entitlements map[EntitlementKey]Entitlement
+
+// Entitlement represents the value of a product feature, which is either a flag or a quota.
+type Entitlement struct {
+	Enabled bool            // Whether the organization is entitled to the feature.
+	Limit   *int            // The quota of the feature, e.g. the number of tests, if the value is a number.
+	Raw     json.RawMessage // The value as returned by the Snyk API.
+}
+
 func (o OrganizationV1) String() string { return Stringify(o) }
 
+func (n NotificationSettings) String() string { return Stringify(n) }
+
+// Enabled reports whether the organization is entitled to key.
+func (e Entitlements) Enabled(key EntitlementKey) bool {
+	return e[key].Enabled
+}
+
+// UnmarshalJSON decodes an entitlement, treating any non-false, non-zero and non-null value
+// as enabled. Integer values are kept as Limit.
+func (e *Entitlement) UnmarshalJSON(data []byte) error {
+	value := bytes.TrimSpace(data)
+	*e = Entitlement{Raw: slices.Clone(value)}
+
+	switch string(value) {
+	case "", "null", "false", `""`:
+		return nil
+	}
+	var number float64
+	if err := json.Unmarshal(value, &number); err != nil {
+		e.Enabled = true
+		return nil
+	}
+	e.Enabled = number != 0
+	if limit := int(number); float64(limit) == number {
+		e.Limit = &limit
+	}
+	return nil
+}
+
 func (s *OrgsServiceV1) Create(ctx context.Context, createRequest *OrganizationV1CreateRequest) (*OrganizationV1, *Response, error) {
 	if createRequest == nil {
 		return nil, nil, errors.New("failed to create organization: payload must be supplied")
@@ -88,3 +230,311 @@ func (s *OrgsServiceV1) Delete(ctx context.Context, orgID string) (*Response, er
 
 	return s.client.do(ctx, req, nil)
 }
+
+func (s *OrgsServiceV1) GetNotificationSettings(ctx context.Context, orgID string) (*NotificationSettings, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to get notification settings: org id must be supplied")
+	}
+
+	path := fmt.Sprintf("%v/%v/notification-settings", orgV1BasePath, orgID)
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, s.client.v1BaseURL, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings := new(NotificationSettings)
+	resp, err := s.client.do(ctx, req, settings)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return settings, resp, nil
+}
+
+func (s *OrgsServiceV1) UpdateNotificationSettings(ctx context.Context, orgID string, updateRequest *NotificationSettingsUpdateRequest) (*NotificationSettings, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to update notification settings: org id must be supplied")
+	}
+	if updateRequest == nil {
+		return nil, nil, errors.New("failed to update notification settings: payload must be supplied")
+	}
+
+	path := fmt.Sprintf("%v/%v/notification-settings", orgV1BasePath, orgID)
+
+	req, err := s.client.prepareRequest(ctx, http.MethodPut, s.client.v1BaseURL, path, updateRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings := new(NotificationSettings)
+	resp, err := s.client.do(ctx, req, settings)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return settings, resp, nil
+}
+
+func (s *OrgsServiceV1) ListEntitlements(ctx context.Context, orgID string) (Entitlements, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to list entitlements: org id must be supplied")
+	}
+
+	path := fmt.Sprintf("%v/%v/entitlements", orgV1BasePath, orgID)
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, s.client.v1BaseURL, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var entitlements Entitlements
+	resp, err := s.client.do(ctx, req, &entitlements)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return entitlements, resp, nil
+}
+
+func (s *OrgsServiceV1) GetEntitlement(ctx context.Context, orgID string, key EntitlementKey) (*Entitlement, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to get entitlement: org id must be supplied")
+	}
+	if key == "" {
+		return nil, nil, errors.New("failed to get entitlement: key must be supplied")
+	}
+
+	path := fmt.Sprintf("%v/%v/entitlement/%v", orgV1BasePath, orgID, url.PathEscape(string(key)))
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, s.client.v1BaseURL, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	entitlement := new(Entitlement)
+	resp, err := s.client.do(ctx, req, entitlement)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return entitlement, resp, nil
+}
+
+// Dependency represents a package used by the projects of an organization.
+type Dependency struct {
+	ID                         string              `json:"id"`                                   // The dependency identifier, i.e. `name@version`.
+	Name                       string              `json:"name"`                                 // The name of the package.
+	Type                       string              `json:"type"`                                 // The package manager of the package, e.g. `maven` or `npm`.
+	Version                    string              `json:"version"`                              // The version of the package.
+	Copyright                  []string            `json:"copyright,omitempty"`                  // The copyright notices of the package.
+	DependenciesWithIssues     []string            `json:"dependenciesWithIssues,omitempty"`     // The transitive dependencies with issues.
+	DeprecatedVersions         []string            `json:"deprecatedVersions,omitempty"`         // The deprecated versions of the package.
+	FirstPublishedDate         string              `json:"firstPublishedDate,omitempty"`         // The time the package was first published.
+	IsDeprecated               bool                `json:"isDeprecated"`                         // Whether the version of the package is deprecated.
+	IssuesCritical             int                 `json:"issuesCritical"`                       // The number of critical issues.
+	IssuesHigh                 int                 `json:"issuesHigh"`                           // The number of high issues.
+	IssuesLow                  int                 `json:"issuesLow"`                            // The number of low issues.
+	IssuesMedium               int                 `json:"issuesMedium"`                         // The number of medium issues.
+	LatestVersion              string              `json:"latestVersion,omitempty"`              // The latest version of the package.
+	LatestVersionPublishedDate string              `json:"latestVersionPublishedDate,omitempty"` // The time the latest version was published.
+	Licenses                   []DependencyLicense `json:"licenses,omitempty"`                   // The licenses of the package.
+	Projects                   []DependencyProject `json:"projects,omitempty"`                   // The projects using the package.
+}
+
+type DependencyLicense struct {
+	ID      string `json:"id"`      // The license identifier, e.g. `snyk:lic:maven:org.apache.logging.log4j:log4j-core:Apache-2.0`.
+	License string `json:"license"` // The SPDX identifier of the license.
+	Title   string `json:"title"`   // The title of the license.
+}
+
+type DependencyProject struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// DependencyFilters restricts the dependencies listed by ListDependencies. Unset fields don't filter.
+type DependencyFilters struct {
+	DepStatus    string   `json:"depStatus,omitempty"`    // Set to 'deprecated' or 'notDeprecated' to filter by deprecation.
+	Dependencies []string `json:"dependencies,omitempty"` // The dependency IDs, e.g. `org.apache.logging.log4j:log4j-core@2.14.1`.
+	Languages    []string `json:"languages,omitempty"`    // The languages, e.g. 'java' or 'javascript'.
+	Licenses     []string `json:"licenses,omitempty"`     // The SPDX identifiers of the licenses.
+	Projects     []string `json:"projects,omitempty"`     // The IDs of the projects.
+	Severity     []string `json:"severity,omitempty"`     // The severities of the issues, e.g. 'critical' or 'high'.
+}
+
+type ListDependenciesOptions struct {
+	Order   string `url:"order,omitempty"`   // The sort order, 'asc' or 'desc'.
+	Page    int    `url:"page,omitempty"`    // The page of results, starting from 1.
+	PerPage int    `url:"perPage,omitempty"` // The number of results per page, at most 1000.
+	SortBy  string `url:"sortBy,omitempty"`  // The field to sort by, e.g. 'dependency' or 'severity'.
+}
+
+type dependencyFiltersRequest struct {
+	Filters *DependencyFilters `json:"filters"`
+}
+
+type dependenciesRoot struct {
+	Results []Dependency `json:"results"`
+	Total   int          `json:"total"`
+}
+
+func (d Dependency) String() string { return Stringify(d) }
+
+func (s *OrgsServiceV1) ListDependencies(ctx context.Context, orgID string, opts *ListDependenciesOptions, filters *DependencyFilters) ([]Dependency, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to list dependencies: org id must be supplied")
+	}
+
+	root, resp, err := s.listDependencies(ctx, orgID, opts, filters)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Results, resp, nil
+}
+
+func (s *OrgsServiceV1) AllDependencies(ctx context.Context, orgID string, opts *ListDependenciesOptions, filters *DependencyFilters) (iter.Seq2[Dependency, *Response], func() error) {
+	if orgID == "" {
+		return errorPaginator[Dependency](errors.New("failed to list dependencies: org id must be supplied"))
+	}
+
+	if opts == nil {
+		opts = &ListDependenciesOptions{}
+	}
+
+	return newPagePaginator(ctx, opts.Page, opts.PerPage, func(page int) ([]Dependency, int, *Response, error) {
+		opts.Page = page
+		root, resp, err := s.listDependencies(ctx, orgID, opts, filters)
+		if err != nil {
+			return nil, 0, resp, err
+		}
+		return root.Results, root.Total, resp, nil
+	})
+}
+
+func (s *OrgsServiceV1) listDependencies(ctx context.Context, orgID string, opts *ListDependenciesOptions, filters *DependencyFilters) (*dependenciesRoot, *Response, error) {
+	if filters == nil {
+		filters = &DependencyFilters{}
+	}
+
+	path, err := addOptions(fmt.Sprintf("%v/%v/dependencies", orgV1BasePath, orgID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodPost, s.client.v1BaseURL, path, dependencyFiltersRequest{Filters: filters})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(dependenciesRoot)
+	resp, err := s.client.do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// License represents a license used by the dependencies of an organization.
+type License struct {
+	ID           string              `json:"id"`                     // The SPDX identifier or expression of the license, e.g. `MIT`.
+	Dependencies []LicenseDependency `json:"dependencies"`           // The dependencies licensed under the license.
+	Instructions string              `json:"instructions,omitempty"` // The instructions of the license policy for the license.
+	Projects     []DependencyProject `json:"projects"`               // The projects using dependencies licensed under the license.
+	Severity     string              `json:"severity,omitempty"`     // The severity of the license policy for the license, e.g. `high` or `none`.
+}
+
+type LicenseDependency struct {
+	ID             string `json:"id"`             // The dependency identifier, i.e. `name@version`.
+	Name           string `json:"name"`           // The name of the package.
+	PackageManager string `json:"packageManager"` // The package manager of the package.
+	Version        string `json:"version"`        // The version of the package.
+}
+
+// LicenseFilters restricts the licenses listed by ListLicenses. Unset fields don't filter.
+type LicenseFilters struct {
+	Dependencies []string `json:"dependencies,omitempty"` // The dependency IDs, e.g. `gulp@3.9.1`.
+	Languages    []string `json:"languages,omitempty"`    // The languages, e.g. 'java' or 'javascript'.
+	Licenses     []string `json:"licenses,omitempty"`     // The SPDX identifiers of the licenses.
+	Projects     []string `json:"projects,omitempty"`     // The IDs of the projects.
+	Severity     []string `json:"severity,omitempty"`     // The severities of the license policy, e.g. 'high' or 'none'.
+}
+
+type ListLicensesOptions struct {
+	Order  string `url:"order,omitempty"`  // The sort order, 'asc' or 'desc'.
+	SortBy string `url:"sortBy,omitempty"` // The field to sort by, e.g. 'license' or 'severity'.
+}
+
+type licenseFiltersRequest struct {
+	Filters *LicenseFilters `json:"filters"`
+}
+
+type licensesRoot struct {
+	Results []License `json:"results"`
+	Total   int       `json:"total"`
+}
+
+func (l License) String() string { return Stringify(l) }
+
+// LicenseFamily returns the family of a license by stripping version and variant from the SPDX
+// identifier, e.g. `GPL` for `GPL-2.0-only` and `BSD` for `BSD-3-Clause`. License expressions,
+// e.g. `MIT OR Apache-2.0`, and custom licenses, e.g. `LicenseRef-acme`, are their own family.
+// Creative Commons licenses keep their variant, e.g. `CC-BY-SA` for `CC-BY-SA-4.0`, as the
+// variants grant different rights.
+func LicenseFamily(licenseID string) string {
+	licenseID = strings.TrimSpace(licenseID)
+	if strings.ContainsAny(licenseID, " ()") ||
+		strings.HasPrefix(licenseID, "LicenseRef-") || strings.HasPrefix(licenseID, "DocumentRef-") {
+		return licenseID
+	}
+	if strings.HasPrefix(licenseID, "CC-") {
+		// strip the version and the port, e.g. `3.0-AT` of `CC-BY-3.0-AT`
+		parts := strings.Split(licenseID, "-")
+		i := slices.IndexFunc(parts, func(part string) bool { return part != "" && part[0] >= '0' && part[0] <= '9' })
+		if i < 0 {
+			return licenseID
+		}
+		return strings.Join(parts[:i], "-")
+	}
+	family, _, _ := strings.Cut(licenseID, "-")
+	return family
+}
+
+// GroupLicensesByFamily groups the licenses by LicenseFamily, keeping the order of the licenses in every group.
+func GroupLicensesByFamily(licenses []License) map[string][]License {
+	families := make(map[string][]License)
+	for _, license := range licenses {
+		family := LicenseFamily(license.ID)
+		families[family] = append(families[family], license)
+	}
+	return families
+}
+
+func (s *OrgsServiceV1) ListLicenses(ctx context.Context, orgID string, opts *ListLicensesOptions, filters *LicenseFilters) ([]License, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to list licenses: org id must be supplied")
+	}
+	if filters == nil {
+		filters = &LicenseFilters{}
+	}
+
+	path, err := addOptions(fmt.Sprintf("%v/%v/licenses", orgV1BasePath, orgID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodPost, s.client.v1BaseURL, path, licenseFiltersRequest{Filters: filters})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(licensesRoot)
+	resp, err := s.client.do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Results, resp, nil
+}
@@ -1,5 +1,14 @@
 package snyk
 
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
 //
 //import (
 //	"encoding/json"
@@ -112,3 +121,308 @@ package snyk
 //	assert.Error(t, err)
 //	assert.Equal(t, ErrEmptyArgument, err)
 //}
+
+func TestOrgsV1_GetNotificationSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/org/org-id/notification-settings", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		_, _ = fmt.Fprint(w, `
+{
+  "new-issues-remediations": { "enabled": true, "issueSeverity": "high", "issueType": "vuln", "inherited": false },
+  "project-imported": { "enabled": false, "inherited": true },
+  "test-limit": { "enabled": true, "inherited": false },
+  "weekly-report": { "enabled": false, "inherited": false }
+}
+`)
+	})
+	expectedSettings := &NotificationSettings{
+		NewIssuesRemediations: &IssuesNotificationSetting{Enabled: true, IssueSeverity: "high", IssueType: "vuln"},
+		ProjectImported:       &NotificationSetting{Inherited: true},
+		TestLimit:             &NotificationSetting{Enabled: true},
+		WeeklyReport:          &NotificationSetting{},
+	}
+
+	actualSettings, _, err := client.OrgsV1.GetNotificationSettings(ctx, "org-id")
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedSettings, actualSettings)
+}
+
+func TestOrgsV1_UpdateNotificationSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/org/org-id/notification-settings", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]any{"weekly-report": map[string]any{"enabled": true}}, body)
+		_, _ = fmt.Fprint(w, `{ "weekly-report": { "enabled": true, "inherited": false } }`)
+	})
+
+	settings, _, err := client.OrgsV1.UpdateNotificationSettings(ctx, "org-id", &NotificationSettingsUpdateRequest{
+		WeeklyReport: &NotificationSettingUpdate{Enabled: true},
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, settings.WeeklyReport.Enabled)
+}
+
+func TestOrgsV1_UpdateNotificationSettings_emptyPayload(t *testing.T) {
+	_, _, err := client.OrgsV1.UpdateNotificationSettings(ctx, "org-id", nil)
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "payload must be supplied")
+}
+
+func TestOrgsV1_ListEntitlements(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/org/org-id/entitlements", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		_, _ = fmt.Fprint(w, `{ "licenses": true, "reports": false, "fullVulnDB": true, "api": 1, "dockerfileFromScm": null, "testLimit": 200 }`)
+	})
+	apiLimit, testLimit := 1, 200
+	expectedEntitlements := Entitlements{
+		EntitlementKeyAPI:               {Enabled: true, Limit: &apiLimit, Raw: json.RawMessage(`1`)},
+		EntitlementKeyDockerfileFromSCM: {Raw: json.RawMessage(`null`)},
+		EntitlementKeyFullVulnDB:        {Enabled: true, Raw: json.RawMessage(`true`)},
+		EntitlementKeyLicenses:          {Enabled: true, Raw: json.RawMessage(`true`)},
+		EntitlementKeyReports:           {Raw: json.RawMessage(`false`)},
+		"testLimit":                     {Enabled: true, Limit: &testLimit, Raw: json.RawMessage(`200`)},
+	}
+
+	actualEntitlements, _, err := client.OrgsV1.ListEntitlements(ctx, "org-id")
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedEntitlements, actualEntitlements)
+	assert.True(t, actualEntitlements.Enabled(EntitlementKeyLicenses))
+	assert.False(t, actualEntitlements.Enabled(EntitlementKeySnykCode))
+}
+
+func TestOrgsV1_ListEntitlements_emptyOrgID(t *testing.T) {
+	_, _, err := client.OrgsV1.ListEntitlements(ctx, "")
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "org id must be supplied")
+}
+
+func TestOrgsV1_GetEntitlement(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/org/org-id/entitlement/reports", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		_, _ = fmt.Fprint(w, `true`)
+	})
+
+	entitlement, _, err := client.OrgsV1.GetEntitlement(ctx, "org-id", EntitlementKeyReports)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &Entitlement{Enabled: true, Raw: json.RawMessage(`true`)}, entitlement)
+}
+
+func TestOrgsV1_GetEntitlement_emptyKey(t *testing.T) {
+	_, _, err := client.OrgsV1.GetEntitlement(ctx, "org-id", "")
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "key must be supplied")
+}
+
+func TestOrgsV1_AllDependencies(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/org/org-id/dependencies", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "1", r.URL.Query().Get("perPage"))
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		expectedBody := map[string]any{
+			"filters": map[string]any{
+				"dependencies": []any{"org.apache.logging.log4j:log4j-core@2.14.1"},
+				"severity":     []any{"critical"},
+			},
+		}
+		assert.Equal(t, expectedBody, body)
+		switch r.URL.Query().Get("page") {
+		case "1":
+			_, _ = fmt.Fprint(w, `
+{
+  "results": [
+    {
+      "id": "org.apache.logging.log4j:log4j-core@2.14.1",
+      "name": "org.apache.logging.log4j:log4j-core",
+      "version": "2.14.1",
+      "type": "maven",
+      "isDeprecated": false,
+      "issuesCritical": 2,
+      "issuesHigh": 1,
+      "issuesMedium": 0,
+      "issuesLow": 0,
+      "licenses": [ { "id": "snyk:lic:maven:org.apache.logging.log4j:log4j-core:Apache-2.0", "license": "Apache-2.0", "title": "Apache-2.0 license" } ],
+      "projects": [ { "id": "project-1", "name": "service-a" } ]
+    }
+  ],
+  "total": 2
+}
+`)
+		case "2":
+			_, _ = fmt.Fprint(w, `{ "results": [ { "id": "org.apache.logging.log4j:log4j-core@2.14.1", "name": "org.apache.logging.log4j:log4j-core", "version": "2.14.1", "projects": [ { "id": "project-2", "name": "service-b" } ] } ], "total": 2 }`)
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+
+	var projectNames []string
+	dependencies, errFn := client.OrgsV1.AllDependencies(ctx, "org-id", &ListDependenciesOptions{PerPage: 1}, &DependencyFilters{
+		Dependencies: []string{"org.apache.logging.log4j:log4j-core@2.14.1"},
+		Severity:     []string{"critical"},
+	})
+	for dependency := range dependencies {
+		for _, project := range dependency.Projects {
+			projectNames = append(projectNames, project.Name)
+		}
+	}
+
+	assert.NoError(t, errFn())
+	assert.Equal(t, []string{"service-a", "service-b"}, projectNames)
+}
+
+func TestOrgsV1_ListDependencies(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/org/org-id/dependencies", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]any{"filters": map[string]any{}}, body)
+		_, _ = fmt.Fprint(w, `{ "results": [ { "id": "gulp@3.9.1", "name": "gulp", "version": "3.9.1", "type": "npm", "latestVersion": "4.0.0" } ], "total": 1 }`)
+	})
+	expectedDependencies := []Dependency{
+		{ID: "gulp@3.9.1", Name: "gulp", Type: "npm", Version: "3.9.1", LatestVersion: "4.0.0"},
+	}
+
+	actualDependencies, _, err := client.OrgsV1.ListDependencies(ctx, "org-id", nil, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedDependencies, actualDependencies)
+}
+
+func TestOrgsV1_AllDependencies_emptyOrgID(t *testing.T) {
+	dependencies, errFn := client.OrgsV1.AllDependencies(ctx, "", nil, nil)
+	for range dependencies {
+		t.Fatal("no dependencies expected")
+	}
+
+	assert.ErrorContains(t, errFn(), "org id must be supplied")
+}
+
+func TestOrgsV1_ListLicenses(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/org/org-id/licenses", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "license", r.URL.Query().Get("sortBy"))
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		expectedBody := map[string]any{
+			"filters": map[string]any{
+				"projects": []any{"project-id"},
+				"severity": []any{"high"},
+			},
+		}
+		assert.Equal(t, expectedBody, body)
+		_, _ = fmt.Fprint(w, `
+{
+  "results": [
+    {
+      "id": "GPL-3.0",
+      "severity": "high",
+      "instructions": "Ask legal before use.",
+      "dependencies": [ { "id": "readline@1.3.0", "name": "readline", "version": "1.3.0", "packageManager": "npm" } ],
+      "projects": [ { "id": "project-id", "name": "service-a" } ]
+    }
+  ],
+  "total": 1
+}
+`)
+	})
+	expectedLicenses := []License{
+		{
+			ID:           "GPL-3.0",
+			Dependencies: []LicenseDependency{{ID: "readline@1.3.0", Name: "readline", PackageManager: "npm", Version: "1.3.0"}},
+			Instructions: "Ask legal before use.",
+			Projects:     []DependencyProject{{ID: "project-id", Name: "service-a"}},
+			Severity:     "high",
+		},
+	}
+
+	actualLicenses, _, err := client.OrgsV1.ListLicenses(ctx, "org-id", &ListLicensesOptions{SortBy: "license"}, &LicenseFilters{
+		Projects: []string{"project-id"},
+		Severity: []string{"high"},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedLicenses, actualLicenses)
+}
+
+func TestOrgsV1_ListLicenses_emptyOrgID(t *testing.T) {
+	_, _, err := client.OrgsV1.ListLicenses(ctx, "", nil, nil)
+
+	assert.ErrorContains(t, err, "org id must be supplied")
+}
+
+func TestLicenseFamily(t *testing.T) {
+	tests := map[string]struct {
+		licenseID      string
+		expectedFamily string
+	}{
+		"without version":    {licenseID: "MIT", expectedFamily: "MIT"},
+		"with version":       {licenseID: "Apache-2.0", expectedFamily: "Apache"},
+		"with variant":       {licenseID: "GPL-2.0-or-later", expectedFamily: "GPL"},
+		"with clause":        {licenseID: "BSD-3-Clause", expectedFamily: "BSD"},
+		"expression":         {licenseID: "(MIT OR Apache-2.0)", expectedFamily: "(MIT OR Apache-2.0)"},
+		"with whitespace":    {licenseID: " LGPL-2.1 ", expectedFamily: "LGPL"},
+		"empty identifier":   {licenseID: "", expectedFamily: ""},
+		"license ref":        {licenseID: "LicenseRef-acme-proprietary", expectedFamily: "LicenseRef-acme-proprietary"},
+		"other license ref":  {licenseID: "LicenseRef-other", expectedFamily: "LicenseRef-other"},
+		"document ref":       {licenseID: "DocumentRef-spdx-tool:LicenseRef-acme", expectedFamily: "DocumentRef-spdx-tool:LicenseRef-acme"},
+		"cc0":                {licenseID: "CC0-1.0", expectedFamily: "CC0"},
+		"cc by":              {licenseID: "CC-BY-4.0", expectedFamily: "CC-BY"},
+		"cc by sa":           {licenseID: "CC-BY-SA-4.0", expectedFamily: "CC-BY-SA"},
+		"cc by nc nd":        {licenseID: "CC-BY-NC-ND-3.0", expectedFamily: "CC-BY-NC-ND"},
+		"cc by ported":       {licenseID: "CC-BY-3.0-AT", expectedFamily: "CC-BY"},
+		"cc without version": {licenseID: "CC-PDDC", expectedFamily: "CC-PDDC"},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expectedFamily, LicenseFamily(test.licenseID))
+		})
+	}
+}
+
+func TestGroupLicensesByFamily(t *testing.T) {
+	licenses := []License{
+		{ID: "GPL-2.0"}, {ID: "MIT"}, {ID: "GPL-3.0-only"}, {ID: "LicenseRef-a"}, {ID: "LicenseRef-b"},
+		{ID: "CC0-1.0"}, {ID: "CC-BY-SA-4.0"}, {ID: "CC-BY-SA-3.0"},
+	}
+
+	families := GroupLicensesByFamily(licenses)
+
+	assert.Equal(t, map[string][]License{
+		"CC-BY-SA":     {{ID: "CC-BY-SA-4.0"}, {ID: "CC-BY-SA-3.0"}},
+		"CC0":          {{ID: "CC0-1.0"}},
+		"GPL":          {{ID: "GPL-2.0"}, {ID: "GPL-3.0-only"}},
+		"LicenseRef-a": {{ID: "LicenseRef-a"}},
+		"LicenseRef-b": {{ID: "LicenseRef-b"}},
+		"MIT":          {{ID: "MIT"}},
+	}, families)
+}
@@ -0,0 +1,270 @@
+package snyk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	packagesBasePath   = orgsBasePath + "/%v/packages"
+	packagesAPIVersion = "2024-10-15"
+)
+
+// PackagesServiceAPI is an interface for interacting with the packages endpoints of the Snyk API.
+//
+// See: https://docs.snyk.io/snyk-api/reference/issues
+type PackagesServiceAPI interface {
+	// ListPackageIssues gets a paginated list of issues affecting the package identified by purl,
+	// e.g. "pkg:npm/lodash@4.17.20". The purl is validated before the request is sent.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/issues#get-orgs-org_id-packages-purl-issues
+	ListPackageIssues(ctx context.Context, orgID, purl string, opts *ListOptions) ([]PackageIssue, *Response, error)
+
+	// AllPackageIssues returns an iterator to paginate over all issues affecting the package identified by purl.
+	//
+	// This method handles the pagination logic internally by calling ListPackageIssues for each page.
+	// The return iterated can be used in a for...range loop to easily process all issues.
+	//
+	// Note: This function is experimental and its signature may change in a future release.
+	AllPackageIssues(ctx context.Context, orgID, purl string, opts *ListOptions) (iter.Seq2[PackageIssue, *Response], func() error)
+
+	// ListIssuesForPackages gets the issues of many packages at once. All pages of the result are
+	// fetched and merged, the result is keyed by the canonical form (see PURL.String) of the supplied purls.
+	// Issues are matched by type, namespace, name and version, so qualifiers and subpath of the supplied
+	// purls do not need to be repeated in the issues. The returned Response is the one of the last page.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/issues#post-orgs-org_id-packages-issues
+	ListIssuesForPackages(ctx context.Context, orgID string, purls []string) (map[string][]PackageIssue, *Response, error)
+}
+
+// PackagesService handles communication with the package related methods of the Snyk API.
+type PackagesService service
+
+var _ PackagesServiceAPI = (*PackagesService)(nil)
+
+// PackageIssue represents an issue, e.g. a vulnerability, affecting a package.
+type PackageIssue struct {
+	ID         string                  `json:"id"`                   // The PackageIssue identifier.
+	Type       string                  `json:"type"`                 // The resource type `issue`.
+	Attributes *PackageIssueAttributes `json:"attributes,omitempty"` // The PackageIssue resource data.
+}
+
+type PackageIssueAttributes struct {
+	Coordinates            []PackageIssueCoordinate `json:"coordinates,omitempty"`              // Where the issue is located and how to fix it.
+	CreatedAt              time.Time                `json:"created_at,omitempty"`               // The time the issue was published.
+	Description            string                   `json:"description,omitempty"`              // The description of the issue in markdown.
+	EffectiveSeverityLevel string                   `json:"effective_severity_level,omitempty"` // The severity of the issue, e.g. 'high'.
+	Key                    string                   `json:"key"`                                // The Snyk issue key, e.g. 'SNYK-JS-LODASH-567746'.
+	Problems               []PackageIssueProblem    `json:"problems,omitempty"`                 // The external identifiers of the issue, e.g. CVEs.
+	Severities             []PackageIssueSeverity   `json:"severities,omitempty"`               // The severities reported by different sources.
+	Title                  string                   `json:"title"`                              // The title of the issue.
+	Type                   string                   `json:"type"`                               // The type of the issue, e.g. 'package_vulnerability'.
+	UpdatedAt              time.Time                `json:"updated_at,omitempty"`               // The time the issue was last modified.
+}
+
+type PackageIssueCoordinate struct {
+	Remedies        []PackageIssueRemedy         `json:"remedies,omitempty"`
+	Representations []PackageIssueRepresentation `json:"representations,omitempty"`
+}
+
+type PackageIssueRemedy struct {
+	Description string `json:"description,omitempty"`
+	Type        string `json:"type"` // The type of the remedy, e.g. 'indeterminate' or 'manual'.
+}
+
+type PackageIssueRepresentation struct {
+	Package *PackageIssuePackage `json:"package,omitempty"`
+}
+
+type PackageIssuePackage struct {
+	Name    string `json:"name"`
+	URL     string `json:"url,omitempty"` // The package URL.
+	Version string `json:"version"`
+}
+
+type PackageIssueProblem struct {
+	ID     string `json:"id"`            // The identifier in the source, e.g. 'CVE-2020-8203'.
+	Source string `json:"source"`        // The source of the identifier, e.g. 'NVD'.
+	URL    string `json:"url,omitempty"` // The URL of the problem in the source.
+}
+
+type PackageIssueSeverity struct {
+	Level  string  `json:"level"`            // The severity level, e.g. 'high'.
+	Score  float64 `json:"score,omitempty"`  // The CVSS score.
+	Source string  `json:"source"`           // The source of the severity, e.g. 'Snyk' or 'NVD'.
+	Vector string  `json:"vector,omitempty"` // The CVSS vector.
+}
+
+type packageIssuesRoot struct {
+	PackageIssues []PackageIssue  `json:"data"`
+	Links         *PaginatedLinks `json:"links,omitempty"`
+}
+
+func (i PackageIssue) String() string { return Stringify(i) }
+
+// packageIssuesPath validates purl and returns the escaped endpoint path for its issues.
+func packageIssuesPath(orgID, purl string) (string, error) {
+	parsedPURL, err := ParsePURL(purl)
+	if err != nil {
+		return "", err
+	}
+	if parsedPURL.Version == "" {
+		return "", fmt.Errorf("invalid purl %q: version must be supplied", purl)
+	}
+
+	return fmt.Sprintf(packagesBasePath+"/%v/issues", orgID, url.PathEscape(parsedPURL.String())), nil
+}
+
+func (s *PackagesService) ListPackageIssues(ctx context.Context, orgID, purl string, opts *ListOptions) ([]PackageIssue, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to list package issues: org id must be supplied")
+	}
+	endpointURL, err := packageIssuesPath(orgID, purl)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list package issues: %w", err)
+	}
+
+	if opts == nil {
+		opts = &ListOptions{}
+	}
+	if opts.Version == "" {
+		opts.Version = packagesAPIVersion
+	}
+
+	path, err := addOptions(endpointURL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, s.client.restBaseURL, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(packageIssuesRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if l := root.Links; l != nil {
+		resp.Links = l
+	}
+
+	return root.PackageIssues, resp, nil
+}
+
+func (s *PackagesService) AllPackageIssues(ctx context.Context, orgID, purl string, opts *ListOptions) (iter.Seq2[PackageIssue, *Response], func() error) {
+	if orgID == "" {
+		return errorPaginator[PackageIssue](errors.New("failed to list package issues: org id must be supplied"))
+	}
+	endpointURL, err := packageIssuesPath(orgID, purl)
+	if err != nil {
+		return errorPaginator[PackageIssue](fmt.Errorf("failed to list package issues: %w", err))
+	}
+
+	if opts == nil {
+		opts = &ListOptions{}
+	}
+	if opts.Version == "" {
+		opts.Version = packagesAPIVersion
+	}
+	return newPaginator[PackageIssue](ctx, s.client, s.client.restBaseURL, endpointURL, opts)
+}
+
+func (s *PackagesService) ListIssuesForPackages(ctx context.Context, orgID string, purls []string) (map[string][]PackageIssue, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to list issues for packages: org id must be supplied")
+	}
+	if len(purls) == 0 {
+		return nil, nil, errors.New("failed to list issues for packages: purls must be supplied")
+	}
+
+	parsedPURLs := make([]*PURL, 0, len(purls))
+	canonicalPURLs := make([]string, 0, len(purls))
+	for _, purl := range purls {
+		parsedPURL, err := ParsePURL(purl)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to list issues for packages: %w", err)
+		}
+		parsedPURLs = append(parsedPURLs, parsedPURL)
+		canonicalPURLs = append(canonicalPURLs, parsedPURL.String())
+	}
+
+	// inline jsonapi create payload to keep function simple
+	var requestJSON struct {
+		Data struct {
+			Attributes struct {
+				PURLs []string `json:"purls"`
+			} `json:"attributes"`
+		} `json:"data"`
+	}
+	requestJSON.Data.Attributes.PURLs = canonicalPURLs
+
+	// several purls can differ in their qualifiers only, so each match key refers to all of them
+	requestedPURLs := make(map[string][]string, len(parsedPURLs))
+	issuesByPURL := make(map[string][]PackageIssue, len(parsedPURLs))
+	for _, parsedPURL := range parsedPURLs {
+		purl := parsedPURL.String()
+		if _, ok := issuesByPURL[purl]; ok {
+			continue
+		}
+		issuesByPURL[purl] = nil
+		key := purlMatchKey(parsedPURL)
+		requestedPURLs[key] = append(requestedPURLs[key], purl)
+	}
+
+	opts := &ListOptions{BaseOptions: BaseOptions{Version: packagesAPIVersion}}
+	issues, issuesErr := newRequestPaginator[PackageIssue](ctx, s.client, http.MethodPost, s.client.restBaseURL,
+		fmt.Sprintf(packagesBasePath+"/issues", orgID), opts, requestJSON)
+	var resp *Response
+	for issue, issueResp := range issues {
+		resp = issueResp
+		for _, key := range issueMatchKeys(issue) {
+			for _, purl := range requestedPURLs[key] {
+				issuesByPURL[purl] = append(issuesByPURL[purl], issue)
+			}
+		}
+	}
+	if err := issuesErr(); err != nil {
+		return nil, resp, err
+	}
+
+	return issuesByPURL, resp, nil
+}
+
+// purlMatchKey returns the canonical form of purl without qualifiers and subpath, which are
+// not always repeated in the coordinates of an issue.
+func purlMatchKey(purl *PURL) string {
+	return PURL{Type: purl.Type, Namespace: purl.Namespace, Name: purl.Name, Version: purl.Version}.String()
+}
+
+// issueMatchKeys returns the distinct match keys (see purlMatchKey) of the packages an issue refers to.
+func issueMatchKeys(issue PackageIssue) []string {
+	if issue.Attributes == nil {
+		return nil
+	}
+
+	var keys []string
+	seen := make(map[string]bool)
+	for _, coordinate := range issue.Attributes.Coordinates {
+		for _, representation := range coordinate.Representations {
+			if representation.Package == nil || representation.Package.URL == "" {
+				continue
+			}
+			parsedPURL, err := ParsePURL(representation.Package.URL)
+			if err != nil {
+				continue
+			}
+			if key := purlMatchKey(parsedPURL); !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	return keys
+}
@@ -0,0 +1,195 @@
+package snyk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testPackageIssueJSON = `
+{
+  "id": "SNYK-JS-LODASH-567746",
+  "type": "issue",
+  "attributes": {
+    "key": "SNYK-JS-LODASH-567746",
+    "title": "Prototype Pollution",
+    "type": "package_vulnerability",
+    "effective_severity_level": "high",
+    "problems": [ { "id": "CVE-2020-8203", "source": "NVD" } ],
+    "coordinates": [
+      {
+        "remedies": [ { "type": "indeterminate", "description": "Upgrade lodash to version 4.17.21 or higher." } ],
+        "representations": [ { "package": { "name": "lodash", "version": "4.17.20", "url": "pkg:npm/lodash@4.17.20" } } ]
+      }
+    ]
+  }
+}
+`
+
+var expectedTestPackageIssue = PackageIssue{
+	ID:   "SNYK-JS-LODASH-567746",
+	Type: "issue",
+	Attributes: &PackageIssueAttributes{
+		Coordinates: []PackageIssueCoordinate{{
+			Remedies:        []PackageIssueRemedy{{Description: "Upgrade lodash to version 4.17.21 or higher.", Type: "indeterminate"}},
+			Representations: []PackageIssueRepresentation{{Package: &PackageIssuePackage{Name: "lodash", URL: "pkg:npm/lodash@4.17.20", Version: "4.17.20"}}},
+		}},
+		EffectiveSeverityLevel: "high",
+		Key:                    "SNYK-JS-LODASH-567746",
+		Problems:               []PackageIssueProblem{{ID: "CVE-2020-8203", Source: "NVD"}},
+		Title:                  "Prototype Pollution",
+		Type:                   "package_vulnerability",
+	},
+}
+
+func TestPackages_ListPackageIssues(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/packages/{purl}/issues", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/orgs/org-id/packages/pkg:npm%2Flodash@4.17.20/issues", r.URL.EscapedPath())
+		_, _ = fmt.Fprintf(w, `{ "data": [ %s ], "links": {} }`, testPackageIssueJSON)
+	})
+
+	actualIssues, _, err := client.Packages.ListPackageIssues(ctx, "org-id", "pkg:npm/lodash@4.17.20", nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []PackageIssue{expectedTestPackageIssue}, actualIssues)
+}
+
+func TestPackages_ListPackageIssues_invalidPURL(t *testing.T) {
+	_, _, err := client.Packages.ListPackageIssues(ctx, "org-id", "npm/lodash", nil)
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "invalid purl")
+}
+
+func TestPackages_ListPackageIssues_missingVersion(t *testing.T) {
+	_, _, err := client.Packages.ListPackageIssues(ctx, "org-id", "pkg:npm/lodash", nil)
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "version must be supplied")
+}
+
+func TestPackages_AllPackageIssues(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/packages/{purl}/issues", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("starting_after") == "" {
+			_, _ = fmt.Fprintf(w, `{ "data": [ %s ], "links": { "next": "/orgs/org-id/packages/pkg%%3Anpm%%2Flodash%%404.17.20/issues?starting_after=cursor" } }`, testPackageIssueJSON)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{ "data": [ %s ], "links": {} }`, testPackageIssueJSON)
+	})
+
+	var actualIssues []PackageIssue
+	issues, iterErr := client.Packages.AllPackageIssues(ctx, "org-id", "pkg:npm/lodash@4.17.20", nil)
+	for issue := range issues {
+		actualIssues = append(actualIssues, issue)
+	}
+
+	assert.NoError(t, iterErr())
+	assert.Equal(t, []PackageIssue{expectedTestPackageIssue, expectedTestPackageIssue}, actualIssues)
+}
+
+func TestPackages_ListIssuesForPackages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/packages/issues", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, map[string]any{
+			"data": map[string]any{
+				"attributes": map[string]any{"purls": []any{"pkg:npm/lodash@4.17.20", "pkg:npm/express@4.18.2"}},
+			},
+		}, body)
+		_, _ = fmt.Fprintf(w, `{ "data": [ %s ] }`, testPackageIssueJSON)
+	})
+	expectedIssues := map[string][]PackageIssue{
+		"pkg:npm/lodash@4.17.20": {expectedTestPackageIssue},
+		"pkg:npm/express@4.18.2": nil,
+	}
+
+	actualIssues, _, err := client.Packages.ListIssuesForPackages(ctx, "org-id", []string{"pkg:NPM/lodash@4.17.20", "pkg:npm/express@4.18.2"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedIssues, actualIssues)
+}
+
+func TestPackages_ListIssuesForPackages_pagination(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/packages/issues", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, map[string]any{
+			"data": map[string]any{
+				"attributes": map[string]any{"purls": []any{"pkg:npm/lodash@4.17.20", "pkg:npm/express@4.18.2"}},
+			},
+		}, body)
+		if r.URL.Query().Get("starting_after") == "" {
+			_, _ = fmt.Fprintf(w, `{ "data": [ %s ], "links": { "next": "/orgs/org-id/packages/issues?version=2024-10-15&starting_after=cursor-1" } }`, testPackageIssueJSON)
+			return
+		}
+		assert.Equal(t, "cursor-1", r.URL.Query().Get("starting_after"))
+		_, _ = fmt.Fprint(w, `
+{
+  "data": [
+    {
+      "id": "SNYK-JS-EXPRESS-6474509",
+      "type": "issue",
+      "attributes": {
+        "key": "SNYK-JS-EXPRESS-6474509",
+        "title": "Open Redirect",
+        "type": "package_vulnerability",
+        "coordinates": [ { "representations": [ { "package": { "name": "express", "version": "4.18.2", "url": "pkg:npm/express@4.18.2" } } ] } ]
+      }
+    }
+  ],
+  "links": {}
+}
+`)
+	})
+
+	actualIssues, _, err := client.Packages.ListIssuesForPackages(ctx, "org-id", []string{"pkg:npm/lodash@4.17.20", "pkg:npm/express@4.18.2"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []PackageIssue{expectedTestPackageIssue}, actualIssues["pkg:npm/lodash@4.17.20"])
+	if assert.Len(t, actualIssues["pkg:npm/express@4.18.2"], 1) {
+		assert.Equal(t, "SNYK-JS-EXPRESS-6474509", actualIssues["pkg:npm/express@4.18.2"][0].ID)
+	}
+}
+
+func TestPackages_ListIssuesForPackages_qualifiers(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/packages/issues", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		_, _ = fmt.Fprintf(w, `{ "data": [ %s ], "links": {} }`, testPackageIssueJSON)
+	})
+	expectedIssues := map[string][]PackageIssue{
+		"pkg:npm/lodash@4.17.20?os=linux": {expectedTestPackageIssue},
+	}
+
+	actualIssues, _, err := client.Packages.ListIssuesForPackages(ctx, "org-id", []string{"pkg:npm/lodash@4.17.20?os=linux"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedIssues, actualIssues)
+}
+
+func TestPackages_ListIssuesForPackages_emptyPURLs(t *testing.T) {
+	_, _, err := client.Packages.ListIssuesForPackages(ctx, "org-id", nil)
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "purls must be supplied")
+}
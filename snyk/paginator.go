@@ -6,8 +6,17 @@ import (
 	"iter"
 	"net/http"
 	"net/url"
+	"reflect"
 )
 
+// paginationOptions is implemented by all option structs embedding ListOptions. It allows newPaginator
+// to move the cursor forward while keeping endpoint specific query parameters (e.g. filters).
+type paginationOptions interface {
+	listOptions() *ListOptions
+}
+
+func (o *ListOptions) listOptions() *ListOptions { return o }
+
 // paginatedResponse is a generic "container" used to unmarshal any paginated list response
 // from Snyk REST API. It assumes the response body has a "data" field (according jsonapi),
 // containing a slice of items and a "links" field for pagination.
@@ -16,11 +25,17 @@ type paginatedResponse[T any] struct {
 	Links *PaginatedLinks `json:"links"`
 }
 
-func newPaginator[T any](ctx context.Context, client *Client, baseURL *url.URL, endpointURL string, opts *ListOptions) (iter.Seq2[T, *Response], func() error) {
+func newPaginator[T any](ctx context.Context, client *Client, baseURL *url.URL, endpointURL string, opts paginationOptions) (iter.Seq2[T, *Response], func() error) {
+	return newRequestPaginator[T](ctx, client, http.MethodGet, baseURL, endpointURL, opts, nil)
+}
+
+// newRequestPaginator works like newPaginator, but sends every page request with method and body,
+// e.g. for endpoints taking their filters as POST payload.
+func newRequestPaginator[T any](ctx context.Context, client *Client, method string, baseURL *url.URL, endpointURL string, opts paginationOptions, body any) (iter.Seq2[T, *Response], func() error) {
 	var iterErr error
 
 	seq := func(yield func(item T, resp *Response) bool) {
-		if opts == nil {
+		if opts == nil || reflect.ValueOf(opts).IsNil() {
 			iterErr = fmt.Errorf("ListOptions cannot be nil, API version is required for endpoint %q", endpointURL)
 			return
 		}
@@ -39,7 +54,7 @@ func newPaginator[T any](ctx context.Context, client *Client, baseURL *url.URL,
 				iterErr = fmt.Errorf("failed to construct URL with options: %w", err)
 				return
 			}
-			req, err := client.prepareRequest(ctx, http.MethodGet, baseURL, path, nil)
+			req, err := client.prepareRequest(ctx, method, baseURL, path, body)
 			if err != nil {
 				iterErr = fmt.Errorf("failed to prepare pagination request: %w", err)
 				return
@@ -73,21 +88,85 @@ func newPaginator[T any](ctx context.Context, client *Client, baseURL *url.URL,
 				iterErr = fmt.Errorf("failed to extract starting_after query param: %w", err)
 				return
 			}
-			opts.StartingAfter = startingAfter
+			opts.listOptions().StartingAfter = startingAfter
 		}
 	}
 
 	return seq, func() error { return iterErr }
 }
 
+// errorPaginator returns an empty iterator which reports err, e.g. when the arguments of an All* method are invalid.
+func errorPaginator[T any](err error) (iter.Seq2[T, *Response], func() error) {
+	return func(func(T, *Response) bool) {}, func() error { return err }
+}
+
 // extractStartingAfterQueryParam extracts the value of the "starting_after" query parameter from a URL path.
 // The Snyk API uses this token for cursor-based pagination.
 func extractStartingAfterQueryParam(path string) (string, error) {
+	return extractQueryParam(path, "starting_after")
+}
+
+// extractQueryParam extracts the value of the query parameter identified by name from a URL path.
+func extractQueryParam(path, name string) (string, error) {
 	u, err := url.Parse(path)
 	if err != nil {
 		return "", fmt.Errorf("failed to parse pagination path: %w", err)
 	}
 
 	q := u.Query()
-	return q.Get("starting_after"), nil
+	return q.Get(name), nil
+}
+
+// newPagePaginator walks the page-number pagination of Snyk V1 API endpoints, which report the total
+// number of items instead of cursor links. fetchPage is called with increasing page numbers, starting
+// from startPage, until a page is empty or the total number of items is read. Without perPage the
+// server default page size applies, it is taken from the size of the first fetched page.
+func newPagePaginator[T any](ctx context.Context, startPage, perPage int, fetchPage func(page int) (items []T, total int, resp *Response, err error)) (iter.Seq2[T, *Response], func() error) {
+	var iterErr error
+
+	if startPage < 1 {
+		startPage = 1
+	}
+
+	seq := func(yield func(item T, resp *Response) bool) {
+		read := 0
+		for page := startPage; ; page++ {
+			select {
+			// if the context has been canceled, the context's error is more useful
+			case <-ctx.Done():
+				iterErr = ctx.Err()
+				return
+			default:
+			}
+
+			items, total, resp, err := fetchPage(page)
+			if err != nil {
+				iterErr = err
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, resp) {
+					// stop iteration if the consumer stops
+					return
+				}
+			}
+
+			if page == startPage {
+				pageSize := perPage
+				if pageSize <= 0 {
+					pageSize = len(items)
+				}
+				// the items of the pages skipped before startPage
+				read = (startPage - 1) * pageSize
+			}
+			read += len(items)
+			if len(items) == 0 || read >= total {
+				// no more next pages, exit from pagination
+				break
+			}
+		}
+	}
+
+	return seq, func() error { return iterErr }
 }
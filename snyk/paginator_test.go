@@ -1,6 +1,7 @@
 package snyk
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -47,3 +48,53 @@ func Test_extractStartingAfterQueryParam(t *testing.T) {
 		})
 	}
 }
+
+func Test_newPagePaginator(t *testing.T) {
+	t.Parallel()
+
+	pages := map[int][]int{1: {1, 2}, 2: {3, 4}, 3: {5, 6}}
+	tests := map[string]struct {
+		startPage       int
+		perPage         int
+		expectedItems   []int
+		expectedFetches []int
+	}{
+		"all pages": {
+			startPage:       1,
+			perPage:         2,
+			expectedItems:   []int{1, 2, 3, 4, 5, 6},
+			expectedFetches: []int{1, 2, 3},
+		},
+		"start page with page size": {
+			startPage:       2,
+			perPage:         2,
+			expectedItems:   []int{3, 4, 5, 6},
+			expectedFetches: []int{2, 3},
+		},
+		"start page with default page size": {
+			startPage:       3,
+			expectedItems:   []int{5, 6},
+			expectedFetches: []int{3},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var fetched []int
+			items, itemsErr := newPagePaginator(context.Background(), test.startPage, test.perPage, func(page int) ([]int, int, *Response, error) {
+				fetched = append(fetched, page)
+				return pages[page], 6, &Response{}, nil
+			})
+
+			var actualItems []int
+			for item := range items {
+				actualItems = append(actualItems, item)
+			}
+
+			assert.NoError(t, itemsErr())
+			assert.Equal(t, test.expectedItems, actualItems)
+			assert.Equal(t, test.expectedFetches, fetched)
+		})
+	}
+}
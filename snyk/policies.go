@@ -0,0 +1,488 @@
+package snyk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+	"time"
+)
+
+const (
+	policiesBasePath   = "policies"
+	policiesAPIVersion = "2024-10-15"
+)
+
+// PoliciesServiceAPI is an interface for interacting with the policies endpoints of the Snyk API.
+//
+// See: https://docs.snyk.io/snyk-api/reference/policies
+type PoliciesServiceAPI interface {
+	// ListOrgPolicies gets a paginated list of policies for an organization.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/policies#get-orgs-org_id-policies
+	ListOrgPolicies(ctx context.Context, orgID string, opts *ListPoliciesOptions) ([]Policy, *Response, error)
+
+	// AllOrgPolicies returns an iterator to paginate over all policies of an organization.
+	//
+	// This method handles the pagination logic internally by calling ListOrgPolicies for each page.
+	// The return iterated can be used in a for...range loop to easily process all policies.
+	//
+	// Note: This function is experimental and its signature may change in a future release.
+	AllOrgPolicies(ctx context.Context, orgID string, opts *ListPoliciesOptions) (iter.Seq2[Policy, *Response], func() error)
+
+	// GetOrgPolicy provides the full details of an organization policy.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/policies#get-orgs-org_id-policies-policy_id
+	GetOrgPolicy(ctx context.Context, orgID, policyID string) (*Policy, *Response, error)
+
+	// CreateOrgPolicy makes a new organization policy.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/policies#post-orgs-org_id-policies
+	CreateOrgPolicy(ctx context.Context, orgID string, createRequest *PolicyCreateRequest) (*Policy, *Response, error)
+
+	// UpdateOrgPolicy changes an organization policy. Only supplied fields are changed.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/policies#patch-orgs-org_id-policies-policy_id
+	UpdateOrgPolicy(ctx context.Context, orgID, policyID string, updateRequest *PolicyUpdateRequest) (*Policy, *Response, error)
+
+	// DeleteOrgPolicy removes an organization policy.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/policies#delete-orgs-org_id-policies-policy_id
+	DeleteOrgPolicy(ctx context.Context, orgID, policyID string) (*Response, error)
+
+	// ListGroupPolicies gets a paginated list of policies for a group.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/policies#get-groups-group_id-policies
+	ListGroupPolicies(ctx context.Context, groupID string, opts *ListPoliciesOptions) ([]Policy, *Response, error)
+
+	// AllGroupPolicies returns an iterator to paginate over all policies of a group.
+	//
+	// This method handles the pagination logic internally by calling ListGroupPolicies for each page.
+	// The return iterated can be used in a for...range loop to easily process all policies.
+	//
+	// Note: This function is experimental and its signature may change in a future release.
+	AllGroupPolicies(ctx context.Context, groupID string, opts *ListPoliciesOptions) (iter.Seq2[Policy, *Response], func() error)
+
+	// GetGroupPolicy provides the full details of a group policy.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/policies#get-groups-group_id-policies-policy_id
+	GetGroupPolicy(ctx context.Context, groupID, policyID string) (*Policy, *Response, error)
+
+	// CreateGroupPolicy makes a new group policy.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/policies#post-groups-group_id-policies
+	CreateGroupPolicy(ctx context.Context, groupID string, createRequest *PolicyCreateRequest) (*Policy, *Response, error)
+
+	// UpdateGroupPolicy changes a group policy. Only supplied fields are changed.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/policies#patch-groups-group_id-policies-policy_id
+	UpdateGroupPolicy(ctx context.Context, groupID, policyID string, updateRequest *PolicyUpdateRequest) (*Policy, *Response, error)
+
+	// DeleteGroupPolicy removes a group policy.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/policies#delete-groups-group_id-policies-policy_id
+	DeleteGroupPolicy(ctx context.Context, groupID, policyID string) (*Response, error)
+}
+
+// PoliciesService handles communication with the policy related methods of the Snyk API.
+type PoliciesService service
+
+var _ PoliciesServiceAPI = (*PoliciesService)(nil)
+
+// Policy represents a Snyk policy applied to matching issues. The policies API supports ignore
+// policies only, so PolicyAction models the ignore action. License and security policies are
+// managed in the Snyk UI and are not covered by this API.
+//
+// See: https://docs.snyk.io/manage-risk/policies
+type Policy struct {
+	ID         string            `json:"id"`                   // The Policy identifier.
+	Type       string            `json:"type"`                 // The resource type `policy`.
+	Attributes *PolicyAttributes `json:"attributes,omitempty"` // The Policy resource data.
+}
+
+type PolicyAttributes struct {
+	Action          *PolicyAction          `json:"action,omitempty"`           // The action applied to matching issues.
+	ActionType      PolicyActionType       `json:"action_type,omitempty"`      // The type of the action, e.g. 'ignore'.
+	ConditionsGroup *PolicyConditionsGroup `json:"conditions_group,omitempty"` // The conditions an issue must match.
+	CreatedAt       time.Time              `json:"created_at,omitempty"`       // The time the Policy was created.
+	CreatedBy       *PolicyActor           `json:"created_by,omitempty"`       // The user who created the Policy.
+	Name            string                 `json:"name"`                       // The name of the Policy.
+	Review          PolicyReviewState      `json:"review,omitempty"`           // The review state of the Policy.
+	UpdatedAt       time.Time              `json:"updated_at,omitempty"`       // The time the Policy was last modified.
+}
+
+// PolicyActionType defines the type of action a policy applies, currently `ignore` only.
+type PolicyActionType string
+
+const (
+	PolicyActionTypeIgnore PolicyActionType = "ignore"
+)
+
+// PolicyAction wraps the action specific data of a policy, i.e. the data of an ignore action.
+type PolicyAction struct {
+	Data *PolicyIgnoreAction `json:"data,omitempty"`
+}
+
+// PolicyIgnoreAction describes how matching issues are ignored.
+type PolicyIgnoreAction struct {
+	Expires    *time.Time       `json:"expires,omitempty"` // The time the ignore expires, never if not set.
+	IgnoreType PolicyIgnoreType `json:"ignore_type"`       // The reason type of the ignore.
+	Reason     string           `json:"reason,omitempty"`  // The free text reason of the ignore.
+}
+
+// PolicyIgnoreType defines the reason type of an ignore policy.
+type PolicyIgnoreType string
+
+const (
+	PolicyIgnoreTypeNotVulnerable   PolicyIgnoreType = "not-vulnerable"
+	PolicyIgnoreTypeTemporaryIgnore PolicyIgnoreType = "temporary-ignore"
+	PolicyIgnoreTypeWontFix         PolicyIgnoreType = "wont-fix"
+)
+
+// PolicyConditionsGroup is a set of conditions combined by a logical operator.
+type PolicyConditionsGroup struct {
+	Conditions      []PolicyCondition `json:"conditions"`
+	LogicalOperator string            `json:"logical_operator"` // The operator combining the conditions, e.g. 'and'.
+}
+
+// PolicyCondition is a single condition an issue must match.
+type PolicyCondition struct {
+	Field    string `json:"field"`    // The issue field to match, e.g. 'snyk/asset/finding/v1'.
+	Operator string `json:"operator"` // The operator to match with, e.g. 'includes'.
+	Value    string `json:"value"`    // The value to match against.
+}
+
+// PolicyReviewState defines the review state of a policy.
+type PolicyReviewState string
+
+const (
+	PolicyReviewStateApproved      PolicyReviewState = "approved"
+	PolicyReviewStateNonApplicable PolicyReviewState = "non-applicable"
+	PolicyReviewStatePending       PolicyReviewState = "pending"
+	PolicyReviewStateRejected      PolicyReviewState = "rejected"
+)
+
+// PolicyActor represents the user acting on a policy.
+type PolicyActor struct {
+	Email string `json:"email,omitempty"`
+	ID    string `json:"id,omitempty"`
+	Name  string `json:"name,omitempty"`
+}
+
+type ListPoliciesOptions struct {
+	ListOptions
+	IgnoreType []PolicyIgnoreType  `url:"ignore_type,comma,omitempty"` // If set, only return policies with these ignore types.
+	Review     []PolicyReviewState `url:"review,comma,omitempty"`      // If set, only return policies in these review states.
+	Search     string              `url:"search,omitempty"`            // If set, only return policies whose name contains this value.
+}
+
+type PolicyCreateRequest struct {
+	Action          *PolicyIgnoreAction
+	ActionType      PolicyActionType // Defaults to PolicyActionTypeIgnore.
+	ConditionsGroup *PolicyConditionsGroup
+	Name            string
+}
+
+type PolicyUpdateRequest struct {
+	Action          *PolicyIgnoreAction
+	ConditionsGroup *PolicyConditionsGroup
+	Name            string
+	Review          PolicyReviewState
+}
+
+type policyRoot struct {
+	Policy *Policy `json:"data,omitempty"`
+}
+
+type policiesRoot struct {
+	Policies []Policy        `json:"data"`
+	Links    *PaginatedLinks `json:"links,omitempty"`
+}
+
+func (p Policy) String() string { return Stringify(p) }
+
+func (s *PoliciesService) ListOrgPolicies(ctx context.Context, orgID string, opts *ListPoliciesOptions) ([]Policy, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to list org policies: org id must be supplied")
+	}
+	return s.list(ctx, fmt.Sprintf("%v/%v/%v", orgsBasePath, orgID, policiesBasePath), opts)
+}
+
+func (s *PoliciesService) AllOrgPolicies(ctx context.Context, orgID string, opts *ListPoliciesOptions) (iter.Seq2[Policy, *Response], func() error) {
+	if orgID == "" {
+		return errorPaginator[Policy](errors.New("failed to list org policies: org id must be supplied"))
+	}
+
+	if opts == nil {
+		opts = &ListPoliciesOptions{}
+	}
+	if opts.Version == "" {
+		opts.Version = policiesAPIVersion
+	}
+	return newPaginator[Policy](ctx, s.client, s.client.restBaseURL, fmt.Sprintf("%v/%v/%v", orgsBasePath, orgID, policiesBasePath), opts)
+}
+
+func (s *PoliciesService) GetOrgPolicy(ctx context.Context, orgID, policyID string) (*Policy, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to get org policy: org id must be supplied")
+	}
+	if policyID == "" {
+		return nil, nil, errors.New("failed to get org policy: id must be supplied")
+	}
+	return s.get(ctx, fmt.Sprintf("%v/%v/%v/%v", orgsBasePath, orgID, policiesBasePath, policyID))
+}
+
+func (s *PoliciesService) CreateOrgPolicy(ctx context.Context, orgID string, createRequest *PolicyCreateRequest) (*Policy, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to create org policy: org id must be supplied")
+	}
+	if createRequest == nil {
+		return nil, nil, errors.New("failed to create org policy: payload must be supplied")
+	}
+	return s.create(ctx, fmt.Sprintf("%v/%v/%v", orgsBasePath, orgID, policiesBasePath), createRequest)
+}
+
+func (s *PoliciesService) UpdateOrgPolicy(ctx context.Context, orgID, policyID string, updateRequest *PolicyUpdateRequest) (*Policy, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to update org policy: org id must be supplied")
+	}
+	if policyID == "" {
+		return nil, nil, errors.New("failed to update org policy: id must be supplied")
+	}
+	if updateRequest == nil {
+		return nil, nil, errors.New("failed to update org policy: payload must be supplied")
+	}
+	return s.update(ctx, fmt.Sprintf("%v/%v/%v/%v", orgsBasePath, orgID, policiesBasePath, policyID), policyID, updateRequest)
+}
+
+func (s *PoliciesService) DeleteOrgPolicy(ctx context.Context, orgID, policyID string) (*Response, error) {
+	if orgID == "" {
+		return nil, errors.New("failed to delete org policy: org id must be supplied")
+	}
+	if policyID == "" {
+		return nil, errors.New("failed to delete org policy: id must be supplied")
+	}
+	return s.delete(ctx, fmt.Sprintf("%v/%v/%v/%v", orgsBasePath, orgID, policiesBasePath, policyID))
+}
+
+func (s *PoliciesService) ListGroupPolicies(ctx context.Context, groupID string, opts *ListPoliciesOptions) ([]Policy, *Response, error) {
+	if groupID == "" {
+		return nil, nil, errors.New("failed to list group policies: group id must be supplied")
+	}
+	return s.list(ctx, fmt.Sprintf("%v/%v/%v", groupsBasePath, groupID, policiesBasePath), opts)
+}
+
+func (s *PoliciesService) AllGroupPolicies(ctx context.Context, groupID string, opts *ListPoliciesOptions) (iter.Seq2[Policy, *Response], func() error) {
+	if groupID == "" {
+		return errorPaginator[Policy](errors.New("failed to list group policies: group id must be supplied"))
+	}
+
+	if opts == nil {
+		opts = &ListPoliciesOptions{}
+	}
+	if opts.Version == "" {
+		opts.Version = policiesAPIVersion
+	}
+	return newPaginator[Policy](ctx, s.client, s.client.restBaseURL, fmt.Sprintf("%v/%v/%v", groupsBasePath, groupID, policiesBasePath), opts)
+}
+
+func (s *PoliciesService) GetGroupPolicy(ctx context.Context, groupID, policyID string) (*Policy, *Response, error) {
+	if groupID == "" {
+		return nil, nil, errors.New("failed to get group policy: group id must be supplied")
+	}
+	if policyID == "" {
+		return nil, nil, errors.New("failed to get group policy: id must be supplied")
+	}
+	return s.get(ctx, fmt.Sprintf("%v/%v/%v/%v", groupsBasePath, groupID, policiesBasePath, policyID))
+}
+
+func (s *PoliciesService) CreateGroupPolicy(ctx context.Context, groupID string, createRequest *PolicyCreateRequest) (*Policy, *Response, error) {
+	if groupID == "" {
+		return nil, nil, errors.New("failed to create group policy: group id must be supplied")
+	}
+	if createRequest == nil {
+		return nil, nil, errors.New("failed to create group policy: payload must be supplied")
+	}
+	return s.create(ctx, fmt.Sprintf("%v/%v/%v", groupsBasePath, groupID, policiesBasePath), createRequest)
+}
+
+func (s *PoliciesService) UpdateGroupPolicy(ctx context.Context, groupID, policyID string, updateRequest *PolicyUpdateRequest) (*Policy, *Response, error) {
+	if groupID == "" {
+		return nil, nil, errors.New("failed to update group policy: group id must be supplied")
+	}
+	if policyID == "" {
+		return nil, nil, errors.New("failed to update group policy: id must be supplied")
+	}
+	if updateRequest == nil {
+		return nil, nil, errors.New("failed to update group policy: payload must be supplied")
+	}
+	return s.update(ctx, fmt.Sprintf("%v/%v/%v/%v", groupsBasePath, groupID, policiesBasePath, policyID), policyID, updateRequest)
+}
+
+func (s *PoliciesService) DeleteGroupPolicy(ctx context.Context, groupID, policyID string) (*Response, error) {
+	if groupID == "" {
+		return nil, errors.New("failed to delete group policy: group id must be supplied")
+	}
+	if policyID == "" {
+		return nil, errors.New("failed to delete group policy: id must be supplied")
+	}
+	return s.delete(ctx, fmt.Sprintf("%v/%v/%v/%v", groupsBasePath, groupID, policiesBasePath, policyID))
+}
+
+func (s *PoliciesService) list(ctx context.Context, endpointURL string, opts *ListPoliciesOptions) ([]Policy, *Response, error) {
+	if opts == nil {
+		opts = &ListPoliciesOptions{}
+	}
+	if opts.Version == "" {
+		opts.Version = policiesAPIVersion
+	}
+
+	path, err := addOptions(endpointURL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, s.client.restBaseURL, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(policiesRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if l := root.Links; l != nil {
+		resp.Links = l
+	}
+
+	return root.Policies, resp, nil
+}
+
+func (s *PoliciesService) get(ctx context.Context, endpointURL string) (*Policy, *Response, error) {
+	opts := BaseOptions{Version: policiesAPIVersion}
+
+	path, err := addOptions(endpointURL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, s.client.restBaseURL, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(policyRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Policy, resp, nil
+}
+
+func (s *PoliciesService) create(ctx context.Context, endpointURL string, createRequest *PolicyCreateRequest) (*Policy, *Response, error) {
+	opts := BaseOptions{Version: policiesAPIVersion}
+
+	path, err := addOptions(endpointURL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// inline jsonapi create payload to keep create function simple
+	var createRequestJSON struct {
+		Data struct {
+			Attributes struct {
+				Action          *PolicyAction          `json:"action,omitempty"`
+				ActionType      PolicyActionType       `json:"action_type,omitempty"`
+				ConditionsGroup *PolicyConditionsGroup `json:"conditions_group,omitempty"`
+				Name            string                 `json:"name"`
+			} `json:"attributes"`
+			Type string `json:"type"`
+		} `json:"data"`
+	}
+	if createRequest.Action != nil {
+		createRequestJSON.Data.Attributes.Action = &PolicyAction{Data: createRequest.Action}
+	}
+	createRequestJSON.Data.Attributes.ActionType = createRequest.ActionType
+	if createRequestJSON.Data.Attributes.ActionType == "" {
+		createRequestJSON.Data.Attributes.ActionType = PolicyActionTypeIgnore
+	}
+	createRequestJSON.Data.Attributes.ConditionsGroup = createRequest.ConditionsGroup
+	createRequestJSON.Data.Attributes.Name = createRequest.Name
+	createRequestJSON.Data.Type = "policy"
+
+	req, err := s.client.prepareRequest(ctx, http.MethodPost, s.client.restBaseURL, path, createRequestJSON)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(policyRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Policy, resp, nil
+}
+
+func (s *PoliciesService) update(ctx context.Context, endpointURL, policyID string, updateRequest *PolicyUpdateRequest) (*Policy, *Response, error) {
+	opts := BaseOptions{Version: policiesAPIVersion}
+
+	path, err := addOptions(endpointURL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// inline jsonapi update payload to keep update function simple
+	var updateRequestJSON struct {
+		Data struct {
+			Attributes struct {
+				Action          *PolicyAction          `json:"action,omitempty"`
+				ConditionsGroup *PolicyConditionsGroup `json:"conditions_group,omitempty"`
+				Name            string                 `json:"name,omitempty"`
+				Review          PolicyReviewState      `json:"review,omitempty"`
+			} `json:"attributes"`
+			ID   string `json:"id"`
+			Type string `json:"type"`
+		} `json:"data"`
+	}
+	if updateRequest.Action != nil {
+		updateRequestJSON.Data.Attributes.Action = &PolicyAction{Data: updateRequest.Action}
+	}
+	updateRequestJSON.Data.Attributes.ConditionsGroup = updateRequest.ConditionsGroup
+	updateRequestJSON.Data.Attributes.Name = updateRequest.Name
+	updateRequestJSON.Data.Attributes.Review = updateRequest.Review
+	updateRequestJSON.Data.ID = policyID
+	updateRequestJSON.Data.Type = "policy"
+
+	req, err := s.client.prepareRequest(ctx, http.MethodPatch, s.client.restBaseURL, path, updateRequestJSON)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(policyRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Policy, resp, nil
+}
+
+func (s *PoliciesService) delete(ctx context.Context, endpointURL string) (*Response, error) {
+	opts := BaseOptions{Version: policiesAPIVersion}
+
+	path, err := addOptions(endpointURL, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodDelete, s.client.restBaseURL, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
@@ -0,0 +1,226 @@
+package snyk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testPolicyJSON = `
+{
+  "id": "policy-id",
+  "type": "policy",
+  "attributes": {
+    "name": "Ignore test fixtures",
+    "action_type": "ignore",
+    "action": {
+      "data": { "ignore_type": "wont-fix", "reason": "test code only", "expires": "2030-01-01T00:00:00Z" }
+    },
+    "conditions_group": {
+      "logical_operator": "and",
+      "conditions": [ { "field": "snyk/asset/finding/v1", "operator": "includes", "value": "finding-id" } ]
+    },
+    "review": "approved",
+    "created_at": "2024-01-02T03:04:05Z",
+    "created_by": { "id": "user-id", "name": "Test User", "email": "test-user@snyk.io" },
+    "updated_at": "2024-01-02T03:04:05Z"
+  }
+}
+`
+
+func expectedTestPolicy() *Policy {
+	expires := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	timestamp := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	return &Policy{
+		ID:   "policy-id",
+		Type: "policy",
+		Attributes: &PolicyAttributes{
+			Action: &PolicyAction{Data: &PolicyIgnoreAction{
+				Expires:    &expires,
+				IgnoreType: PolicyIgnoreTypeWontFix,
+				Reason:     "test code only",
+			}},
+			ActionType: PolicyActionTypeIgnore,
+			ConditionsGroup: &PolicyConditionsGroup{
+				Conditions:      []PolicyCondition{{Field: "snyk/asset/finding/v1", Operator: "includes", Value: "finding-id"}},
+				LogicalOperator: "and",
+			},
+			CreatedAt: timestamp,
+			CreatedBy: &PolicyActor{Email: "test-user@snyk.io", ID: "user-id", Name: "Test User"},
+			Name:      "Ignore test fixtures",
+			Review:    PolicyReviewStateApproved,
+			UpdatedAt: timestamp,
+		},
+	}
+}
+
+func TestPolicies_ListOrgPolicies(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/policies", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "approved,pending", r.URL.Query().Get("review"))
+		_, _ = fmt.Fprintf(w, `{ "data": [ %s ], "links": {} }`, testPolicyJSON)
+	})
+
+	actualPolicies, _, err := client.Policies.ListOrgPolicies(ctx, "org-id", &ListPoliciesOptions{
+		Review: []PolicyReviewState{PolicyReviewStateApproved, PolicyReviewStatePending},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []Policy{*expectedTestPolicy()}, actualPolicies)
+}
+
+func TestPolicies_ListOrgPolicies_emptyOrgID(t *testing.T) {
+	_, _, err := client.Policies.ListOrgPolicies(ctx, "", nil)
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "org id must be supplied")
+}
+
+func TestPolicies_AllGroupPolicies_emptyGroupID(t *testing.T) {
+	policies, iterErr := client.Policies.AllGroupPolicies(ctx, "", nil)
+	for range policies {
+		assert.Fail(t, "no policies expected")
+	}
+
+	assert.Error(t, iterErr())
+	assert.ErrorContains(t, iterErr(), "group id must be supplied")
+}
+
+func TestPolicies_GetGroupPolicy(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/groups/group-id/policies/policy-id", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		_, _ = fmt.Fprintf(w, `{ "data": %s }`, testPolicyJSON)
+	})
+
+	actualPolicy, _, err := client.Policies.GetGroupPolicy(ctx, "group-id", "policy-id")
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedTestPolicy(), actualPolicy)
+}
+
+func TestPolicies_CreateOrgPolicy(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/policies", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, map[string]any{
+			"data": map[string]any{
+				"attributes": map[string]any{
+					"action":      map[string]any{"data": map[string]any{"ignore_type": "wont-fix", "reason": "test code only"}},
+					"action_type": "ignore",
+					"conditions_group": map[string]any{
+						"logical_operator": "and",
+						"conditions":       []any{map[string]any{"field": "snyk/asset/finding/v1", "operator": "includes", "value": "finding-id"}},
+					},
+					"name": "Ignore test fixtures",
+				},
+				"type": "policy",
+			},
+		}, body)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprintf(w, `{ "data": %s }`, testPolicyJSON)
+	})
+
+	actualPolicy, _, err := client.Policies.CreateOrgPolicy(ctx, "org-id", &PolicyCreateRequest{
+		Action: &PolicyIgnoreAction{IgnoreType: PolicyIgnoreTypeWontFix, Reason: "test code only"},
+		ConditionsGroup: &PolicyConditionsGroup{
+			Conditions:      []PolicyCondition{{Field: "snyk/asset/finding/v1", Operator: "includes", Value: "finding-id"}},
+			LogicalOperator: "and",
+		},
+		Name: "Ignore test fixtures",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedTestPolicy(), actualPolicy)
+}
+
+func TestPolicies_CreateOrgPolicy_withoutOptionalFields(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/policies", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, map[string]any{
+			"data": map[string]any{
+				"attributes": map[string]any{
+					"action_type": "ignore",
+					"name":        "Ignore test fixtures",
+				},
+				"type": "policy",
+			},
+		}, body)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprintf(w, `{ "data": %s }`, testPolicyJSON)
+	})
+
+	_, _, err := client.Policies.CreateOrgPolicy(ctx, "org-id", &PolicyCreateRequest{Name: "Ignore test fixtures"})
+
+	assert.NoError(t, err)
+}
+
+func TestPolicies_CreateOrgPolicy_emptyPayload(t *testing.T) {
+	_, _, err := client.Policies.CreateOrgPolicy(ctx, "org-id", nil)
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "payload must be supplied")
+}
+
+func TestPolicies_UpdateOrgPolicy(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/policies/policy-id", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, map[string]any{
+			"data": map[string]any{
+				"attributes": map[string]any{"review": "approved"},
+				"id":         "policy-id",
+				"type":       "policy",
+			},
+		}, body)
+		_, _ = fmt.Fprintf(w, `{ "data": %s }`, testPolicyJSON)
+	})
+
+	actualPolicy, _, err := client.Policies.UpdateOrgPolicy(ctx, "org-id", "policy-id", &PolicyUpdateRequest{Review: PolicyReviewStateApproved})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedTestPolicy(), actualPolicy)
+}
+
+func TestPolicies_DeleteGroupPolicy(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/groups/group-id/policies/policy-id", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Policies.DeleteGroupPolicy(ctx, "group-id", "policy-id")
+
+	assert.NoError(t, err)
+}
+
+func TestPolicies_DeleteGroupPolicy_emptyPolicyID(t *testing.T) {
+	_, err := client.Policies.DeleteGroupPolicy(ctx, "group-id", "")
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "id must be supplied")
+}
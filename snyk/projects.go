@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"iter"
 	"net/http"
 	"time"
 )
@@ -22,6 +23,14 @@ type ProjectsServiceAPI interface {
 	// See: https://docs.snyk.io/snyk-api/reference/projects#get-orgs-org_id-projects
 	List(ctx context.Context, orgID string, opts *ListProjectsOptions) ([]Project, *Response, error)
 
+	// All returns an iterator to paginate over all projects for the organization.
+	//
+	// This method handles the pagination logic internally by calling List for each page.
+	// The return iterated can be used in a for...range loop to easily process all projects.
+	//
+	// Note: This function is experimental and its signature may change in a future release.
+	All(ctx context.Context, orgID string, opts *ListProjectsOptions) (iter.Seq2[Project, *Response], func() error)
+
 	// Get provides the full details about the project.
 	//
 	// See: https://docs.snyk.io/snyk-api/reference/projects#get-orgs-org_id-projects-project_id
@@ -54,6 +63,7 @@ type ProjectAttributes struct {
 
 type ListProjectsOptions struct {
 	ListOptions
+	TargetIDs []string `url:"target_id,comma,omitempty"` // If set, only return projects of these targets.
 }
 
 type projectRoot struct {
@@ -99,6 +109,18 @@ func (s *ProjectsService) List(ctx context.Context, orgID string, opts *ListProj
 	return root.Projects, resp, nil
 }
 
+func (s *ProjectsService) All(ctx context.Context, orgID string, opts *ListProjectsOptions) (iter.Seq2[Project, *Response], func() error) {
+	if orgID == "" {
+		return errorPaginator[Project](errors.New("orgID must be supplied"))
+	}
+
+	if opts == nil {
+		opts = &ListProjectsOptions{ListOptions: ListOptions{Limit: 100}}
+	}
+	opts.Version = projectsAPIVersion
+	return newPaginator[Project](ctx, s.client, s.client.restBaseURL, fmt.Sprintf(projectsBaseBase, orgID), opts)
+}
+
 func (s *ProjectsService) Get(ctx context.Context, orgID, projectID string) (*Project, *Response, error) {
 	if orgID == "" {
 		return nil, nil, errors.New("orgID must be supplied")
@@ -174,3 +174,30 @@ func TestProject_Get_emptyProjectID(t *testing.T) {
 	assert.Error(t, err)
 	assert.ErrorContains(t, err, "projectID must be supplied")
 }
+
+func TestProject_All(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/projects", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "target-1,target-2", r.URL.Query().Get("target_id"))
+		if r.URL.Query().Get("starting_after") == "" {
+			_, _ = fmt.Fprint(w, `{
+  "data": [ { "id": "project-1", "type": "project", "attributes": { "name": "first" } } ],
+  "links": { "next": "/orgs/org-id/projects?starting_after=cursor-1" }
+}`)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{ "data": [ { "id": "project-2", "type": "project", "attributes": { "name": "second" } } ], "links": {} }`)
+	})
+
+	var projectIDs []string
+	projects, errFn := client.Projects.All(ctx, "org-id", &ListProjectsOptions{TargetIDs: []string{"target-1", "target-2"}})
+	for project := range projects {
+		projectIDs = append(projectIDs, project.ID)
+	}
+
+	assert.NoError(t, errFn())
+	assert.Equal(t, []string{"project-1", "project-2"}, projectIDs)
+}
@@ -0,0 +1,757 @@
+package snyk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+	"slices"
+	"time"
+)
+
+const projectV1BasePath = orgV1BasePath + "/%v/project/%v"
+
+// ProjectsServiceV1API is an interface for interacting with the projects endpoints of the Snyk V1 API.
+//
+// Note: Snyk V1 API endpoints are being gradually deprecated. It is recommended
+// to use the REST API via ProjectsServiceAPI where possible.
+//
+// See: https://docs.snyk.io/snyk-api/reference/projects-v1
+type ProjectsServiceV1API interface {
+	// ListIgnores provides all ignores of a project, keyed by issue id.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/ignores-v1#get-org-orgid-project-projectid-ignores
+	ListIgnores(ctx context.Context, orgID, projectID string) (map[string][]IgnoreRule, *Response, error)
+
+	// GetIgnore provides the ignores of a single issue in a project.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/ignores-v1#get-org-orgid-project-projectid-ignore-issueid
+	GetIgnore(ctx context.Context, orgID, projectID, issueID string) ([]IgnoreRule, *Response, error)
+
+	// AddIgnore ignores an issue in a project.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/ignores-v1#post-org-orgid-project-projectid-ignore-issueid
+	AddIgnore(ctx context.Context, orgID, projectID, issueID string, rule *IgnoreRule) ([]IgnoreRule, *Response, error)
+
+	// ReplaceIgnores replaces all ignores of an issue in a project with rules.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/ignores-v1#put-org-orgid-project-projectid-ignore-issueid
+	ReplaceIgnores(ctx context.Context, orgID, projectID, issueID string, rules []IgnoreRule) ([]IgnoreRule, *Response, error)
+
+	// DeleteIgnore removes all ignores of an issue in a project, i.e. un-ignores the issue.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/ignores-v1#delete-org-orgid-project-projectid-ignore-issueid
+	DeleteIgnore(ctx context.Context, orgID, projectID, issueID string) (*Response, error)
+
+	// BulkAddIgnore ignores the same issue with the same rule in all given projects of an organization.
+	// Projects are processed one after another, a failure for one project doesn't stop processing
+	// of the others. The outcome for every project is reported in the returned results.
+	BulkAddIgnore(ctx context.Context, orgID string, projectIDs []string, issueID string, rule *IgnoreRule) ([]IgnoreResult, error)
+
+	// GetDepGraph provides the dependency graph of the latest snapshot of a project.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/projects-v1#get-org-orgid-project-projectid-dep-graph
+	GetDepGraph(ctx context.Context, orgID, projectID string) (*DepGraph, *Response, error)
+
+	// ListHistory gets a page of the snapshots of a project, newest first. A snapshot is taken by every
+	// test of a monitored project.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/projects-v1#post-org-orgid-project-projectid-history
+	ListHistory(ctx context.Context, orgID, projectID string, opts *ListProjectHistoryOptions) ([]ProjectSnapshot, *Response, error)
+
+	// AllHistory returns an iterator to paginate over all snapshots of a project, newest first.
+	//
+	// This method handles the pagination logic internally by calling ListHistory for each page.
+	// The return iterated can be used in a for...range loop to easily process all snapshots.
+	//
+	// Note: This function is experimental and its signature may change in a future release.
+	AllHistory(ctx context.Context, orgID, projectID string, opts *ListProjectHistoryOptions) (iter.Seq2[ProjectSnapshot, *Response], func() error)
+
+	// GetSnapshotIssues provides the issues of a project at a snapshot, aggregated per issue and package.
+	// Use DiffSnapshotIssues to compare the issues of two snapshots.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/projects-v1#post-org-orgid-project-projectid-history-snapshotid-aggregated-issues
+	GetSnapshotIssues(ctx context.Context, orgID, projectID, snapshotID string, opts *SnapshotIssuesOptions) ([]AggregatedIssue, *Response, error)
+
+	// Move transfers a project to another organization. The user must be an administrator of both
+	// organizations, usually they belong to the same group.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/projects-v1#put-org-orgid-project-projectid-move
+	Move(ctx context.Context, orgID, projectID, targetOrgID string) (*ProjectMove, *Response, error)
+
+	// Activate enables the monitoring of a deactivated project.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/projects-v1#post-org-orgid-project-projectid-activate
+	Activate(ctx context.Context, orgID, projectID string) (*Response, error)
+
+	// Deactivate disables the monitoring of a project, e.g. no more tests and pull requests are run.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/projects-v1#post-org-orgid-project-projectid-deactivate
+	Deactivate(ctx context.Context, orgID, projectID string) (*Response, error)
+
+	// BulkMove moves all projects of a target from one organization to another. Projects are processed
+	// one after another, a failure for one project doesn't stop processing of the others. The outcome
+	// for every project is reported in the returned results. With BulkMoveOptions.DryRun the projects
+	// are only listed, but not moved.
+	BulkMove(ctx context.Context, orgID, targetID, targetOrgID string, opts *BulkMoveOptions) ([]MoveResult, error)
+}
+
+// ProjectsServiceV1 handles communication with the project related methods of the Snyk V1 API.
+type ProjectsServiceV1 service
+
+var _ ProjectsServiceV1API = (*ProjectsServiceV1)(nil)
+
+// IgnoreReasonType defines why an issue is ignored.
+type IgnoreReasonType string
+
+const (
+	IgnoreReasonTypeNotVulnerable   IgnoreReasonType = "not-vulnerable"
+	IgnoreReasonTypeTemporaryIgnore IgnoreReasonType = "temporary-ignore"
+	IgnoreReasonTypeWontFix         IgnoreReasonType = "wont-fix"
+)
+
+// IgnoreRule represents an ignore of an issue on a single dependency path.
+type IgnoreRule struct {
+	Path               string           `json:"-"`                    // The dependency path to ignore, "*" (or empty when creating) for all paths.
+	CreatedAt          time.Time        `json:"created,omitempty"`    // The time the ignore was created.
+	DisregardIfFixable bool             `json:"disregardIfFixable"`   // Only ignore the issue as long as no upgrade or patch is available.
+	Expires            *time.Time       `json:"expires,omitempty"`    // The time the ignore expires, never if not set.
+	IgnoredBy          *IgnoreRuleActor `json:"ignoredBy,omitempty"`  // The user who ignored the issue.
+	Reason             string           `json:"reason,omitempty"`     // The free text reason of the ignore.
+	ReasonType         IgnoreReasonType `json:"reasonType,omitempty"` // The reason type of the ignore.
+	Source             string           `json:"source,omitempty"`     // Where the ignore was created, e.g. 'cli' or 'api'.
+}
+
+// IgnoreRuleActor represents the user acting on an ignore.
+type IgnoreRuleActor struct {
+	Email string `json:"email,omitempty"`
+	ID    string `json:"id,omitempty"`
+	Name  string `json:"name,omitempty"`
+}
+
+// IgnoreResult reports the outcome of BulkAddIgnore for a single project.
+type IgnoreResult struct {
+	ProjectID string
+	Rules     []IgnoreRule // The ignores of the issue after the change, nil if Err is set.
+	Response  *Response
+	Err       error
+}
+
+func (r IgnoreRule) String() string { return Stringify(r) }
+
+// ignoreRulesJSON is the V1 wire format of ignores: a list of single-key objects mapping the path to the rule.
+type ignoreRulesJSON []map[string]IgnoreRule
+
+// UnmarshalJSON accepts the list format as well as a single object, which is returned by some endpoints.
+func (rs *ignoreRulesJSON) UnmarshalJSON(data []byte) error {
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		var pathRule map[string]IgnoreRule
+		if err := json.Unmarshal(trimmed, &pathRule); err != nil {
+			return err
+		}
+		*rs = ignoreRulesJSON{pathRule}
+		return nil
+	}
+
+	var pathRules []map[string]IgnoreRule
+	if err := json.Unmarshal(data, &pathRules); err != nil {
+		return err
+	}
+	*rs = pathRules
+	return nil
+}
+
+func (rs ignoreRulesJSON) rules() []IgnoreRule {
+	rules := make([]IgnoreRule, 0, len(rs))
+	for _, pathRule := range rs {
+		paths := make([]string, 0, len(pathRule))
+		for path := range pathRule {
+			paths = append(paths, path)
+		}
+		slices.Sort(paths)
+		for _, path := range paths {
+			rule := pathRule[path]
+			rule.Path = path
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+// ignoreRuleRequestJSON is the V1 payload to create or replace an ignore.
+type ignoreRuleRequestJSON struct {
+	DisregardIfFixable bool             `json:"disregardIfFixable"`
+	Expires            *time.Time       `json:"expires,omitempty"`
+	IgnorePath         string           `json:"ignorePath,omitempty"`
+	Reason             string           `json:"reason,omitempty"`
+	ReasonType         IgnoreReasonType `json:"reasonType,omitempty"`
+}
+
+func newIgnoreRuleRequestJSON(rule IgnoreRule) ignoreRuleRequestJSON {
+	return ignoreRuleRequestJSON{
+		DisregardIfFixable: rule.DisregardIfFixable,
+		Expires:            rule.Expires,
+		IgnorePath:         rule.Path,
+		Reason:             rule.Reason,
+		ReasonType:         rule.ReasonType,
+	}
+}
+
+func (s *ProjectsServiceV1) ListIgnores(ctx context.Context, orgID, projectID string) (map[string][]IgnoreRule, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to list ignores: org id must be supplied")
+	}
+	if projectID == "" {
+		return nil, nil, errors.New("failed to list ignores: project id must be supplied")
+	}
+
+	path := fmt.Sprintf(projectV1BasePath+"/ignores", orgID, projectID)
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, s.client.v1BaseURL, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var root map[string]ignoreRulesJSON
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	ignores := make(map[string][]IgnoreRule, len(root))
+	for issueID, rules := range root {
+		ignores[issueID] = rules.rules()
+	}
+
+	return ignores, resp, nil
+}
+
+func (s *ProjectsServiceV1) GetIgnore(ctx context.Context, orgID, projectID, issueID string) ([]IgnoreRule, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to get ignore: org id must be supplied")
+	}
+	if projectID == "" {
+		return nil, nil, errors.New("failed to get ignore: project id must be supplied")
+	}
+	if issueID == "" {
+		return nil, nil, errors.New("failed to get ignore: issue id must be supplied")
+	}
+
+	path := fmt.Sprintf(projectV1BasePath+"/ignore/%v", orgID, projectID, issueID)
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, s.client.v1BaseURL, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var root ignoreRulesJSON
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.rules(), resp, nil
+}
+
+func (s *ProjectsServiceV1) AddIgnore(ctx context.Context, orgID, projectID, issueID string, rule *IgnoreRule) ([]IgnoreRule, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to add ignore: org id must be supplied")
+	}
+	if projectID == "" {
+		return nil, nil, errors.New("failed to add ignore: project id must be supplied")
+	}
+	if issueID == "" {
+		return nil, nil, errors.New("failed to add ignore: issue id must be supplied")
+	}
+	if rule == nil {
+		return nil, nil, errors.New("failed to add ignore: payload must be supplied")
+	}
+
+	path := fmt.Sprintf(projectV1BasePath+"/ignore/%v", orgID, projectID, issueID)
+
+	req, err := s.client.prepareRequest(ctx, http.MethodPost, s.client.v1BaseURL, path, newIgnoreRuleRequestJSON(*rule))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var root ignoreRulesJSON
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.rules(), resp, nil
+}
+
+func (s *ProjectsServiceV1) ReplaceIgnores(ctx context.Context, orgID, projectID, issueID string, rules []IgnoreRule) ([]IgnoreRule, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to replace ignores: org id must be supplied")
+	}
+	if projectID == "" {
+		return nil, nil, errors.New("failed to replace ignores: project id must be supplied")
+	}
+	if issueID == "" {
+		return nil, nil, errors.New("failed to replace ignores: issue id must be supplied")
+	}
+	if len(rules) == 0 {
+		return nil, nil, errors.New("failed to replace ignores: payload must be supplied")
+	}
+
+	path := fmt.Sprintf(projectV1BasePath+"/ignore/%v", orgID, projectID, issueID)
+
+	payload := make([]ignoreRuleRequestJSON, 0, len(rules))
+	for _, rule := range rules {
+		payload = append(payload, newIgnoreRuleRequestJSON(rule))
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodPut, s.client.v1BaseURL, path, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var root ignoreRulesJSON
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.rules(), resp, nil
+}
+
+func (s *ProjectsServiceV1) DeleteIgnore(ctx context.Context, orgID, projectID, issueID string) (*Response, error) {
+	if orgID == "" {
+		return nil, errors.New("failed to delete ignore: org id must be supplied")
+	}
+	if projectID == "" {
+		return nil, errors.New("failed to delete ignore: project id must be supplied")
+	}
+	if issueID == "" {
+		return nil, errors.New("failed to delete ignore: issue id must be supplied")
+	}
+
+	path := fmt.Sprintf(projectV1BasePath+"/ignore/%v", orgID, projectID, issueID)
+
+	req, err := s.client.prepareRequest(ctx, http.MethodDelete, s.client.v1BaseURL, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
+
+func (s *ProjectsServiceV1) BulkAddIgnore(ctx context.Context, orgID string, projectIDs []string, issueID string, rule *IgnoreRule) ([]IgnoreResult, error) {
+	if orgID == "" {
+		return nil, errors.New("failed to bulk add ignore: org id must be supplied")
+	}
+	if issueID == "" {
+		return nil, errors.New("failed to bulk add ignore: issue id must be supplied")
+	}
+	if rule == nil {
+		return nil, errors.New("failed to bulk add ignore: payload must be supplied")
+	}
+
+	results := make([]IgnoreResult, 0, len(projectIDs))
+	for _, projectID := range projectIDs {
+		// don't send further requests once the context has been canceled, report its error instead
+		if err := ctx.Err(); err != nil {
+			results = append(results, IgnoreResult{ProjectID: projectID, Err: err})
+			continue
+		}
+
+		rules, resp, err := s.AddIgnore(ctx, orgID, projectID, issueID, rule)
+		results = append(results, IgnoreResult{ProjectID: projectID, Rules: rules, Response: resp, Err: err})
+	}
+
+	return results, nil
+}
+
+type depGraphRoot struct {
+	DepGraph *DepGraph `json:"depGraph"`
+}
+
+func (s *ProjectsServiceV1) GetDepGraph(ctx context.Context, orgID, projectID string) (*DepGraph, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to get dep-graph: org id must be supplied")
+	}
+	if projectID == "" {
+		return nil, nil, errors.New("failed to get dep-graph: project id must be supplied")
+	}
+
+	path := fmt.Sprintf(projectV1BasePath+"/dep-graph", orgID, projectID)
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, s.client.v1BaseURL, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(depGraphRoot)
+	resp, err := s.client.do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.DepGraph, resp, nil
+}
+
+// ProjectSnapshot represents the state of a project at a test.
+type ProjectSnapshot struct {
+	ID                string               `json:"id"`                       // The ProjectSnapshot identifier.
+	Created           time.Time            `json:"created"`                  // The time the snapshot was taken.
+	ImageBaseImage    string               `json:"imageBaseImage,omitempty"` // The base image of a container project.
+	ImageID           string               `json:"imageId,omitempty"`        // The image ID of a container project.
+	ImageTag          string               `json:"imageTag,omitempty"`       // The image tag of a container project.
+	IssueCounts       *SnapshotIssueCounts `json:"issueCounts,omitempty"`    // The number of issues per type and severity.
+	Method            string               `json:"method,omitempty"`         // How the snapshot was taken, e.g. 'cli', 'recurring' or 'api'.
+	TotalDependencies int                  `json:"totalDependencies"`        // The number of dependencies.
+}
+
+type SnapshotIssueCounts struct {
+	License *ReportingSeverityCounts `json:"license,omitempty"`
+	Vuln    *ReportingSeverityCounts `json:"vuln,omitempty"`
+}
+
+type ListProjectHistoryOptions struct {
+	Page    int `url:"page,omitempty"`    // The page of results, starting from 1.
+	PerPage int `url:"perPage,omitempty"` // The number of results per page, at most 100.
+}
+
+// AggregatedIssue represents an issue of a package in a project.
+type AggregatedIssue struct {
+	ID                string                             `json:"id"`                          // The issue identifier.
+	FixInfo           *AggregatedIssueFix                `json:"fixInfo,omitempty"`           // The fix information of the issue.
+	IntroducedThrough []AggregatedIssueIntroducedThrough `json:"introducedThrough,omitempty"` // How the package was introduced, only included on request.
+	IsIgnored         bool                               `json:"isIgnored"`                   // Whether the issue is ignored.
+	IsPatched         bool                               `json:"isPatched"`                   // Whether the issue is patched.
+	IssueData         *AggregatedIssueData               `json:"issueData,omitempty"`         // The details of the issue.
+	IssueType         string                             `json:"issueType"`                   // The issue type, `vuln` or `license`.
+	PkgName           string                             `json:"pkgName"`                     // The name of the affected package.
+	PkgVersions       []string                           `json:"pkgVersions,omitempty"`       // The affected versions of the package.
+	Priority          *AggregatedIssuePriority           `json:"priority,omitempty"`          // The priority score of the issue.
+}
+
+type AggregatedIssueData struct {
+	ID              string              `json:"id"`                        // The issue identifier.
+	Title           string              `json:"title"`                     // The title of the issue.
+	Severity        string              `json:"severity"`                  // The severity of the issue.
+	CVSSScore       float64             `json:"cvssScore,omitempty"`       // The CVSS score of the issue.
+	Description     string              `json:"description,omitempty"`     // The description of the issue, only included on request.
+	DisclosureTime  *time.Time          `json:"disclosureTime,omitempty"`  // The time the issue was disclosed.
+	ExploitMaturity string              `json:"exploitMaturity,omitempty"` // The exploit maturity of the issue.
+	Identifiers     map[string][]string `json:"identifiers,omitempty"`     // The external identifiers of the issue, e.g. CVE or CWE.
+	PublicationTime *time.Time          `json:"publicationTime,omitempty"` // The time the issue was published by Snyk.
+	URL             string              `json:"url,omitempty"`             // The URL of the issue in the Snyk vulnerability database.
+}
+
+type AggregatedIssueFix struct {
+	FixedIn               []string `json:"fixedIn,omitempty"`               // The versions of the package without the issue.
+	IsFixable             bool     `json:"isFixable"`                       // Whether all paths of the issue can be fixed.
+	IsPartiallyFixable    bool     `json:"isPartiallyFixable"`              // Whether some paths of the issue can be fixed.
+	IsPatchable           bool     `json:"isPatchable"`                     // Whether a patch is available.
+	IsPinnable            bool     `json:"isPinnable"`                      // Whether the package can be pinned to a version without the issue.
+	IsUpgradable          bool     `json:"isUpgradable"`                    // Whether a direct dependency can be upgraded to fix the issue.
+	NearestFixedInVersion string   `json:"nearestFixedInVersion,omitempty"` // The nearest version of the package without the issue.
+}
+
+// AggregatedIssueIntroducedThrough describes how the affected package was introduced into a
+// container project, e.g. by an image layer.
+type AggregatedIssueIntroducedThrough struct {
+	Kind string         `json:"kind"`           // The kind of the introduction, e.g. 'imageLayer'.
+	Data map[string]any `json:"data,omitempty"` // The kind specific details.
+}
+
+type AggregatedIssuePriority struct {
+	Score int `json:"score"` // The priority score between 0 and 1000.
+}
+
+// SnapshotIssuesOptions specifies the issues returned by GetSnapshotIssues.
+type SnapshotIssuesOptions struct {
+	Filters                  *AggregatedIssueFilters `json:"filters,omitempty"`
+	IncludeDescription       bool                    `json:"includeDescription,omitempty"`       // Whether to include the description of the issues.
+	IncludeIntroducedThrough bool                    `json:"includeIntroducedThrough,omitempty"` // Whether to include how the packages were introduced, container projects only.
+}
+
+// AggregatedIssueFilters restricts the issues returned by GetSnapshotIssues. Unset fields don't filter.
+type AggregatedIssueFilters struct {
+	ExploitMaturity []string `json:"exploitMaturity,omitempty"` // The exploit maturities, e.g. 'mature' or 'no-known-exploit'.
+	Ignored         *bool    `json:"ignored,omitempty"`         // If set, only include issues which are (not) ignored.
+	Patched         *bool    `json:"patched,omitempty"`         // If set, only include issues which are (not) patched.
+	Severities      []string `json:"severities,omitempty"`      // The severities, e.g. 'critical' or 'high'.
+	Types           []string `json:"types,omitempty"`           // The issue types, 'vuln' or 'license'.
+}
+
+// SnapshotIssuesDiff is the difference of the issues of two snapshots, see DiffSnapshotIssues.
+type SnapshotIssuesDiff struct {
+	Fixed      []AggregatedIssue // The issues of the older snapshot missing in the newer one.
+	Introduced []AggregatedIssue // The issues of the newer snapshot missing in the older one.
+}
+
+type projectHistoryRoot struct {
+	Snapshots []ProjectSnapshot `json:"snapshots"`
+	Total     int               `json:"total"`
+}
+
+type aggregatedIssuesRoot struct {
+	Issues []AggregatedIssue `json:"issues"`
+}
+
+func (ps ProjectSnapshot) String() string { return Stringify(ps) }
+
+func (ai AggregatedIssue) String() string { return Stringify(ai) }
+
+// DiffSnapshotIssues compares the issues of an older and a newer snapshot, see GetSnapshotIssues. Issues
+// are matched by issue ID and package name, so an issue still affecting a package in another version
+// is neither introduced nor fixed. The issues keep the order of the snapshot they are taken from.
+func DiffSnapshotIssues(older, newer []AggregatedIssue) SnapshotIssuesDiff {
+	key := func(issue AggregatedIssue) string { return issue.ID + "@" + issue.PkgName }
+
+	olderKeys := make(map[string]struct{}, len(older))
+	for _, issue := range older {
+		olderKeys[key(issue)] = struct{}{}
+	}
+	newerKeys := make(map[string]struct{}, len(newer))
+	for _, issue := range newer {
+		newerKeys[key(issue)] = struct{}{}
+	}
+
+	var diff SnapshotIssuesDiff
+	for _, issue := range older {
+		if _, ok := newerKeys[key(issue)]; !ok {
+			diff.Fixed = append(diff.Fixed, issue)
+		}
+	}
+	for _, issue := range newer {
+		if _, ok := olderKeys[key(issue)]; !ok {
+			diff.Introduced = append(diff.Introduced, issue)
+		}
+	}
+	return diff
+}
+
+func (s *ProjectsServiceV1) ListHistory(ctx context.Context, orgID, projectID string, opts *ListProjectHistoryOptions) ([]ProjectSnapshot, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to list project history: org id must be supplied")
+	}
+	if projectID == "" {
+		return nil, nil, errors.New("failed to list project history: project id must be supplied")
+	}
+
+	root, resp, err := s.listHistory(ctx, orgID, projectID, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Snapshots, resp, nil
+}
+
+func (s *ProjectsServiceV1) AllHistory(ctx context.Context, orgID, projectID string, opts *ListProjectHistoryOptions) (iter.Seq2[ProjectSnapshot, *Response], func() error) {
+	if orgID == "" {
+		return errorPaginator[ProjectSnapshot](errors.New("failed to list project history: org id must be supplied"))
+	}
+	if projectID == "" {
+		return errorPaginator[ProjectSnapshot](errors.New("failed to list project history: project id must be supplied"))
+	}
+
+	if opts == nil {
+		opts = &ListProjectHistoryOptions{}
+	}
+
+	return newPagePaginator(ctx, opts.Page, opts.PerPage, func(page int) ([]ProjectSnapshot, int, *Response, error) {
+		opts.Page = page
+		root, resp, err := s.listHistory(ctx, orgID, projectID, opts)
+		if err != nil {
+			return nil, 0, resp, err
+		}
+		return root.Snapshots, root.Total, resp, nil
+	})
+}
+
+func (s *ProjectsServiceV1) GetSnapshotIssues(ctx context.Context, orgID, projectID, snapshotID string, opts *SnapshotIssuesOptions) ([]AggregatedIssue, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to get snapshot issues: org id must be supplied")
+	}
+	if projectID == "" {
+		return nil, nil, errors.New("failed to get snapshot issues: project id must be supplied")
+	}
+	if snapshotID == "" {
+		return nil, nil, errors.New("failed to get snapshot issues: snapshot id must be supplied")
+	}
+	if opts == nil {
+		opts = &SnapshotIssuesOptions{}
+	}
+
+	path := fmt.Sprintf(projectV1BasePath+"/history/%v/aggregated-issues", orgID, projectID, snapshotID)
+
+	req, err := s.client.prepareRequest(ctx, http.MethodPost, s.client.v1BaseURL, path, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(aggregatedIssuesRoot)
+	resp, err := s.client.do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Issues, resp, nil
+}
+
+func (s *ProjectsServiceV1) listHistory(ctx context.Context, orgID, projectID string, opts *ListProjectHistoryOptions) (*projectHistoryRoot, *Response, error) {
+	path, err := addOptions(fmt.Sprintf(projectV1BasePath+"/history", orgID, projectID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the history filters are only relevant for container projects and not supported yet
+	body := struct {
+		Filters struct{} `json:"filters"`
+	}{}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodPost, s.client.v1BaseURL, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(projectHistoryRoot)
+	resp, err := s.client.do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// ProjectMove represents a project moved between organizations.
+type ProjectMove struct {
+	DestinationOrgID string `json:"destinationOrg"` // The ID of the organization the project was moved to.
+	MovedProjectID   string `json:"movedProject"`   // The ID of the moved project.
+	OriginOrgID      string `json:"originOrg"`      // The ID of the organization the project was moved from.
+}
+
+type BulkMoveOptions struct {
+	DryRun bool // If set, the projects are reported, but not moved.
+}
+
+// MoveResult is the outcome of moving a single project by BulkMove.
+type MoveResult struct {
+	ProjectID   string
+	ProjectName string
+	Moved       bool // Whether the project was moved, always false for a dry run.
+	Response    *Response
+	Err         error
+}
+
+func (s *ProjectsServiceV1) Move(ctx context.Context, orgID, projectID, targetOrgID string) (*ProjectMove, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to move project: org id must be supplied")
+	}
+	if projectID == "" {
+		return nil, nil, errors.New("failed to move project: project id must be supplied")
+	}
+	if targetOrgID == "" {
+		return nil, nil, errors.New("failed to move project: target org id must be supplied")
+	}
+
+	path := fmt.Sprintf(projectV1BasePath+"/move", orgID, projectID)
+	body := struct {
+		TargetOrgID string `json:"targetOrgId"`
+	}{TargetOrgID: targetOrgID}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodPut, s.client.v1BaseURL, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	move := new(ProjectMove)
+	resp, err := s.client.do(ctx, req, move)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return move, resp, nil
+}
+
+func (s *ProjectsServiceV1) Activate(ctx context.Context, orgID, projectID string) (*Response, error) {
+	if orgID == "" {
+		return nil, errors.New("failed to activate project: org id must be supplied")
+	}
+	if projectID == "" {
+		return nil, errors.New("failed to activate project: project id must be supplied")
+	}
+
+	return s.changeActivation(ctx, fmt.Sprintf(projectV1BasePath+"/activate", orgID, projectID))
+}
+
+func (s *ProjectsServiceV1) Deactivate(ctx context.Context, orgID, projectID string) (*Response, error) {
+	if orgID == "" {
+		return nil, errors.New("failed to deactivate project: org id must be supplied")
+	}
+	if projectID == "" {
+		return nil, errors.New("failed to deactivate project: project id must be supplied")
+	}
+
+	return s.changeActivation(ctx, fmt.Sprintf(projectV1BasePath+"/deactivate", orgID, projectID))
+}
+
+func (s *ProjectsServiceV1) BulkMove(ctx context.Context, orgID, targetID, targetOrgID string, opts *BulkMoveOptions) ([]MoveResult, error) {
+	if orgID == "" {
+		return nil, errors.New("failed to bulk move projects: org id must be supplied")
+	}
+	if targetID == "" {
+		return nil, errors.New("failed to bulk move projects: target id must be supplied")
+	}
+	if targetOrgID == "" {
+		return nil, errors.New("failed to bulk move projects: target org id must be supplied")
+	}
+	if opts == nil {
+		opts = &BulkMoveOptions{}
+	}
+
+	// collect all projects first, moving projects while paginating would shift the cursor
+	var projects []Project
+	projectsSeq, errFn := s.client.Projects.All(ctx, orgID, &ListProjectsOptions{
+		ListOptions: ListOptions{Limit: 100},
+		TargetIDs:   []string{targetID},
+	})
+	for project := range projectsSeq {
+		projects = append(projects, project)
+	}
+	if err := errFn(); err != nil {
+		return nil, fmt.Errorf("failed to bulk move projects: %w", err)
+	}
+
+	results := make([]MoveResult, 0, len(projects))
+	for _, project := range projects {
+		result := MoveResult{ProjectID: project.ID}
+		if project.Attributes != nil {
+			result.ProjectName = project.Attributes.Name
+		}
+		if opts.DryRun {
+			results = append(results, result)
+			continue
+		}
+		// don't send further requests once the context has been canceled, report its error instead
+		if err := ctx.Err(); err != nil {
+			result.Err = err
+			results = append(results, result)
+			continue
+		}
+
+		_, result.Response, result.Err = s.Move(ctx, orgID, project.ID, targetOrgID)
+		result.Moved = result.Err == nil
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func (s *ProjectsServiceV1) changeActivation(ctx context.Context, endpointURL string) (*Response, error) {
+	req, err := s.client.prepareRequest(ctx, http.MethodPost, s.client.v1BaseURL, endpointURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
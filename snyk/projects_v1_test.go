@@ -0,0 +1,498 @@
+package snyk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProjectsV1_ListIgnores(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/org/org-id/project/project-id/ignores", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		_, _ = fmt.Fprint(w, `
+{
+  "SNYK-JS-LODASH-567746": [
+    {
+      "*": {
+        "reason": "no fix available",
+        "reasonType": "wont-fix",
+        "created": "2024-01-02T03:04:05Z",
+        "expires": "2030-01-01T00:00:00Z",
+        "ignoredBy": { "id": "user-id", "name": "Test User", "email": "test-user@snyk.io" },
+        "disregardIfFixable": true,
+        "source": "api"
+      }
+    }
+  ]
+}
+`)
+	})
+	expires := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	expectedIgnores := map[string][]IgnoreRule{
+		"SNYK-JS-LODASH-567746": {{
+			Path:               "*",
+			CreatedAt:          time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			DisregardIfFixable: true,
+			Expires:            &expires,
+			IgnoredBy:          &IgnoreRuleActor{Email: "test-user@snyk.io", ID: "user-id", Name: "Test User"},
+			Reason:             "no fix available",
+			ReasonType:         IgnoreReasonTypeWontFix,
+			Source:             "api",
+		}},
+	}
+
+	actualIgnores, _, err := client.ProjectsV1.ListIgnores(ctx, "org-id", "project-id")
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedIgnores, actualIgnores)
+}
+
+func TestProjectsV1_ListIgnores_emptyProjectID(t *testing.T) {
+	_, _, err := client.ProjectsV1.ListIgnores(ctx, "org-id", "")
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "project id must be supplied")
+}
+
+func TestProjectsV1_AddIgnore(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/org/org-id/project/project-id/ignore/issue-id", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, map[string]any{
+			"disregardIfFixable": false,
+			"reason":             "not reachable",
+			"reasonType":         "not-vulnerable",
+		}, body)
+		_, _ = fmt.Fprint(w, `{ "*": { "reason": "not reachable", "reasonType": "not-vulnerable", "disregardIfFixable": false } }`)
+	})
+	expectedRules := []IgnoreRule{{Path: "*", Reason: "not reachable", ReasonType: IgnoreReasonTypeNotVulnerable}}
+
+	actualRules, _, err := client.ProjectsV1.AddIgnore(ctx, "org-id", "project-id", "issue-id", &IgnoreRule{
+		Reason:     "not reachable",
+		ReasonType: IgnoreReasonTypeNotVulnerable,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedRules, actualRules)
+}
+
+func TestProjectsV1_AddIgnore_emptyPayload(t *testing.T) {
+	_, _, err := client.ProjectsV1.AddIgnore(ctx, "org-id", "project-id", "issue-id", nil)
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "payload must be supplied")
+}
+
+func TestProjectsV1_ReplaceIgnores(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/org/org-id/project/project-id/ignore/issue-id", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		var body []map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, []map[string]any{
+			{"disregardIfFixable": true, "ignorePath": "a > b", "reasonType": "temporary-ignore", "expires": "2030-01-01T00:00:00Z"},
+		}, body)
+		_, _ = fmt.Fprint(w, `[ { "a > b": { "reasonType": "temporary-ignore", "disregardIfFixable": true, "expires": "2030-01-01T00:00:00Z" } } ]`)
+	})
+	expires := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	rules := []IgnoreRule{{Path: "a > b", DisregardIfFixable: true, Expires: &expires, ReasonType: IgnoreReasonTypeTemporaryIgnore}}
+
+	actualRules, _, err := client.ProjectsV1.ReplaceIgnores(ctx, "org-id", "project-id", "issue-id", rules)
+
+	assert.NoError(t, err)
+	assert.Equal(t, rules, actualRules)
+}
+
+func TestProjectsV1_DeleteIgnore(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/org/org-id/project/project-id/ignore/issue-id", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+	})
+
+	_, err := client.ProjectsV1.DeleteIgnore(ctx, "org-id", "project-id", "issue-id")
+
+	assert.NoError(t, err)
+}
+
+func TestProjectsV1_BulkAddIgnore(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/org/org-id/project/project-1/ignore/issue-id", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{ "*": { "reasonType": "wont-fix", "disregardIfFixable": false } }`)
+	})
+	mux.HandleFunc("/org/org-id/project/project-2/ignore/issue-id", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprint(w, `{ "message": "Project not found", "errorRef": "error-ref" }`)
+	})
+
+	results, err := client.ProjectsV1.BulkAddIgnore(ctx, "org-id", []string{"project-1", "project-2"}, "issue-id", &IgnoreRule{ReasonType: IgnoreReasonTypeWontFix})
+
+	assert.NoError(t, err)
+	assert.Len(t, results, 2)
+	assert.Equal(t, "project-1", results[0].ProjectID)
+	assert.NoError(t, results[0].Err)
+	assert.Equal(t, []IgnoreRule{{Path: "*", ReasonType: IgnoreReasonTypeWontFix}}, results[0].Rules)
+	assert.Equal(t, "project-2", results[1].ProjectID)
+	assert.ErrorContains(t, results[1].Err, "Project not found")
+	assert.Nil(t, results[1].Rules)
+}
+
+func TestProjectsV1_GetDepGraph(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/org/org-id/project/project-id/dep-graph", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		_, _ = fmt.Fprint(w, `
+{
+  "depGraph": {
+    "schemaVersion": "1.2.0",
+    "pkgManager": { "name": "maven" },
+    "pkgs": [
+      { "id": "com.example:app@1.0.0", "info": { "name": "com.example:app", "version": "1.0.0" } },
+      { "id": "org.apache.logging.log4j:log4j-core@2.14.1", "info": { "name": "org.apache.logging.log4j:log4j-core", "version": "2.14.1" } }
+    ],
+    "graph": {
+      "rootNodeId": "root-node",
+      "nodes": [
+        { "nodeId": "root-node", "pkgId": "com.example:app@1.0.0", "deps": [ { "nodeId": "org.apache.logging.log4j:log4j-core@2.14.1" } ] },
+        { "nodeId": "org.apache.logging.log4j:log4j-core@2.14.1", "pkgId": "org.apache.logging.log4j:log4j-core@2.14.1", "deps": [], "info": { "labels": { "scope": "compile" } } }
+      ]
+    }
+  }
+}
+`)
+	})
+	expectedDepGraph := &DepGraph{
+		SchemaVersion: "1.2.0",
+		PkgManager:    DepGraphPkgManager{Name: "maven"},
+		Pkgs: []DepGraphPkg{
+			{ID: "com.example:app@1.0.0", Info: DepGraphPkgInfo{Name: "com.example:app", Version: "1.0.0"}},
+			{ID: "org.apache.logging.log4j:log4j-core@2.14.1", Info: DepGraphPkgInfo{Name: "org.apache.logging.log4j:log4j-core", Version: "2.14.1"}},
+		},
+		Graph: DepGraphGraph{
+			RootNodeID: "root-node",
+			Nodes: []DepGraphNode{
+				{NodeID: "root-node", PkgID: "com.example:app@1.0.0", Deps: []DepGraphNodeRef{{NodeID: "org.apache.logging.log4j:log4j-core@2.14.1"}}},
+				{
+					NodeID: "org.apache.logging.log4j:log4j-core@2.14.1",
+					PkgID:  "org.apache.logging.log4j:log4j-core@2.14.1",
+					Deps:   []DepGraphNodeRef{},
+					Info:   &DepGraphNodeInfo{Labels: map[string]string{"scope": "compile"}},
+				},
+			},
+		},
+	}
+
+	actualDepGraph, _, err := client.ProjectsV1.GetDepGraph(ctx, "org-id", "project-id")
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedDepGraph, actualDepGraph)
+}
+
+func TestProjectsV1_GetDepGraph_emptyProjectID(t *testing.T) {
+	_, _, err := client.ProjectsV1.GetDepGraph(ctx, "org-id", "")
+
+	assert.ErrorContains(t, err, "project id must be supplied")
+}
+
+func TestProjectsV1_AllHistory(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/org/org-id/project/project-id/history", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		switch r.URL.Query().Get("page") {
+		case "1":
+			_, _ = fmt.Fprint(w, `
+{
+  "snapshots": [
+    {
+      "id": "snapshot-2",
+      "created": "2024-01-02T03:04:05Z",
+      "totalDependencies": 42,
+      "issueCounts": { "vuln": { "critical": 1, "high": 2, "medium": 0, "low": 3 }, "license": { "critical": 0, "high": 0, "medium": 1, "low": 0 } },
+      "method": "recurring"
+    }
+  ],
+  "total": 2
+}
+`)
+		case "2":
+			_, _ = fmt.Fprint(w, `{ "snapshots": [ { "id": "snapshot-1", "created": "2024-01-01T03:04:05Z", "totalDependencies": 40, "method": "cli" } ], "total": 2 }`)
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	})
+
+	var snapshots []ProjectSnapshot
+	history, errFn := client.ProjectsV1.AllHistory(ctx, "org-id", "project-id", &ListProjectHistoryOptions{PerPage: 1})
+	for snapshot := range history {
+		snapshots = append(snapshots, snapshot)
+	}
+
+	assert.NoError(t, errFn())
+	if assert.Len(t, snapshots, 2) {
+		assert.Equal(t, ProjectSnapshot{
+			ID:                "snapshot-2",
+			Created:           time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			IssueCounts:       &SnapshotIssueCounts{License: &ReportingSeverityCounts{Medium: 1}, Vuln: &ReportingSeverityCounts{Critical: 1, High: 2, Low: 3}},
+			Method:            "recurring",
+			TotalDependencies: 42,
+		}, snapshots[0])
+		assert.Equal(t, "snapshot-1", snapshots[1].ID)
+	}
+}
+
+func TestProjectsV1_AllHistory_emptyProjectID(t *testing.T) {
+	history, errFn := client.ProjectsV1.AllHistory(ctx, "org-id", "", nil)
+	for range history {
+		t.Fatal("no snapshots expected")
+	}
+
+	assert.ErrorContains(t, errFn(), "project id must be supplied")
+}
+
+func TestProjectsV1_GetSnapshotIssues(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/org/org-id/project/project-id/history/snapshot-id/aggregated-issues", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		expectedBody := map[string]any{
+			"filters":            map[string]any{"ignored": false, "severities": []any{"critical"}},
+			"includeDescription": true,
+		}
+		assert.Equal(t, expectedBody, body)
+		_, _ = fmt.Fprint(w, `
+{
+  "issues": [
+    {
+      "id": "SNYK-JAVA-ORGAPACHELOGGINGLOG4J-2314720",
+      "issueType": "vuln",
+      "pkgName": "org.apache.logging.log4j:log4j-core",
+      "pkgVersions": [ "2.14.1" ],
+      "issueData": { "id": "SNYK-JAVA-ORGAPACHELOGGINGLOG4J-2314720", "title": "Remote Code Execution (RCE)", "severity": "critical", "cvssScore": 10, "description": "Log4Shell" },
+      "isIgnored": false,
+      "isPatched": false,
+      "fixInfo": { "isUpgradable": true, "isPinnable": false, "isPatchable": false, "isFixable": true, "isPartiallyFixable": false, "nearestFixedInVersion": "2.15.0", "fixedIn": [ "2.15.0" ] },
+      "priority": { "score": 919 }
+    }
+  ]
+}
+`)
+	})
+	ignored := false
+	expectedIssues := []AggregatedIssue{
+		{
+			ID:          "SNYK-JAVA-ORGAPACHELOGGINGLOG4J-2314720",
+			FixInfo:     &AggregatedIssueFix{FixedIn: []string{"2.15.0"}, IsFixable: true, IsUpgradable: true, NearestFixedInVersion: "2.15.0"},
+			IssueData:   &AggregatedIssueData{ID: "SNYK-JAVA-ORGAPACHELOGGINGLOG4J-2314720", Title: "Remote Code Execution (RCE)", Severity: "critical", CVSSScore: 10, Description: "Log4Shell"},
+			IssueType:   "vuln",
+			PkgName:     "org.apache.logging.log4j:log4j-core",
+			PkgVersions: []string{"2.14.1"},
+			Priority:    &AggregatedIssuePriority{Score: 919},
+		},
+	}
+
+	actualIssues, _, err := client.ProjectsV1.GetSnapshotIssues(ctx, "org-id", "project-id", "snapshot-id", &SnapshotIssuesOptions{
+		Filters:            &AggregatedIssueFilters{Ignored: &ignored, Severities: []string{"critical"}},
+		IncludeDescription: true,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedIssues, actualIssues)
+}
+
+func TestProjectsV1_GetSnapshotIssues_introducedThrough(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/org/org-id/project/project-id/history/snapshot-id/aggregated-issues", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]any{"includeIntroducedThrough": true}, body)
+		_, _ = fmt.Fprint(w, `
+{
+  "issues": [
+    {
+      "id": "SNYK-DEBIAN12-ZLIB-6008963",
+      "issueType": "vuln",
+      "pkgName": "zlib/zlib1g",
+      "introducedThrough": [ { "kind": "imageLayer", "data": { "layer": "sha256:abc" } } ]
+    }
+  ]
+}
+`)
+	})
+	expectedIssues := []AggregatedIssue{
+		{
+			ID:                "SNYK-DEBIAN12-ZLIB-6008963",
+			IntroducedThrough: []AggregatedIssueIntroducedThrough{{Kind: "imageLayer", Data: map[string]any{"layer": "sha256:abc"}}},
+			IssueType:         "vuln",
+			PkgName:           "zlib/zlib1g",
+		},
+	}
+
+	actualIssues, _, err := client.ProjectsV1.GetSnapshotIssues(ctx, "org-id", "project-id", "snapshot-id", &SnapshotIssuesOptions{
+		IncludeIntroducedThrough: true,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedIssues, actualIssues)
+}
+
+func TestProjectsV1_GetSnapshotIssues_emptySnapshotID(t *testing.T) {
+	_, _, err := client.ProjectsV1.GetSnapshotIssues(ctx, "org-id", "project-id", "", nil)
+
+	assert.ErrorContains(t, err, "snapshot id must be supplied")
+}
+
+func TestDiffSnapshotIssues(t *testing.T) {
+	older := []AggregatedIssue{
+		{ID: "issue-1", PkgName: "a", PkgVersions: []string{"1.0.0"}},
+		{ID: "issue-2", PkgName: "b"},
+		{ID: "issue-3", PkgName: "c"},
+	}
+	newer := []AggregatedIssue{
+		{ID: "issue-1", PkgName: "a", PkgVersions: []string{"1.1.0"}},
+		{ID: "issue-3", PkgName: "d"},
+		{ID: "issue-4", PkgName: "e"},
+	}
+
+	diff := DiffSnapshotIssues(older, newer)
+
+	assert.Equal(t, []AggregatedIssue{{ID: "issue-2", PkgName: "b"}, {ID: "issue-3", PkgName: "c"}}, diff.Fixed)
+	assert.Equal(t, []AggregatedIssue{{ID: "issue-3", PkgName: "d"}, {ID: "issue-4", PkgName: "e"}}, diff.Introduced)
+}
+
+func TestProjectsV1_Move(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/org/org-id/project/project-id/move", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]any{"targetOrgId": "target-org-id"}, body)
+		_, _ = fmt.Fprint(w, `{ "originOrg": "org-id", "destinationOrg": "target-org-id", "movedProject": "project-id" }`)
+	})
+	expectedMove := &ProjectMove{DestinationOrgID: "target-org-id", MovedProjectID: "project-id", OriginOrgID: "org-id"}
+
+	actualMove, _, err := client.ProjectsV1.Move(ctx, "org-id", "project-id", "target-org-id")
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedMove, actualMove)
+}
+
+func TestProjectsV1_Move_emptyTargetOrgID(t *testing.T) {
+	_, _, err := client.ProjectsV1.Move(ctx, "org-id", "project-id", "")
+
+	assert.ErrorContains(t, err, "target org id must be supplied")
+}
+
+func TestProjectsV1_Activate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/org/org-id/project/project-id/activate", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+	})
+
+	_, err := client.ProjectsV1.Activate(ctx, "org-id", "project-id")
+
+	assert.NoError(t, err)
+}
+
+func TestProjectsV1_Deactivate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/org/org-id/project/project-id/deactivate", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+	})
+
+	_, err := client.ProjectsV1.Deactivate(ctx, "org-id", "project-id")
+
+	assert.NoError(t, err)
+}
+
+func TestProjectsV1_BulkMove(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/projects", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "target-id", r.URL.Query().Get("target_id"))
+		_, _ = fmt.Fprint(w, `{
+  "data": [
+    { "id": "project-1", "type": "project", "attributes": { "name": "first" } },
+    { "id": "project-2", "type": "project", "attributes": { "name": "second" } }
+  ],
+  "links": {}
+}`)
+	})
+	mux.HandleFunc("/org/org-id/project/project-1/move", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		_, _ = fmt.Fprint(w, `{ "originOrg": "org-id", "destinationOrg": "target-org-id", "movedProject": "project-1" }`)
+	})
+	mux.HandleFunc("/org/org-id/project/project-2/move", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = fmt.Fprint(w, `{ "code": 403, "message": "Forbidden", "error": "not an admin of the target org" }`)
+	})
+
+	results, err := client.ProjectsV1.BulkMove(ctx, "org-id", "target-id", "target-org-id", nil)
+
+	assert.NoError(t, err)
+	if assert.Len(t, results, 2) {
+		assert.Equal(t, "project-1", results[0].ProjectID)
+		assert.Equal(t, "first", results[0].ProjectName)
+		assert.True(t, results[0].Moved)
+		assert.NoError(t, results[0].Err)
+		assert.Equal(t, "project-2", results[1].ProjectID)
+		assert.False(t, results[1].Moved)
+		assert.Error(t, results[1].Err)
+	}
+}
+
+func TestProjectsV1_BulkMove_dryRun(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/projects", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{ "data": [ { "id": "project-1", "type": "project", "attributes": { "name": "first" } } ], "links": {} }`)
+	})
+	mux.HandleFunc("/org/org-id/project/project-1/move", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("project must not be moved in a dry run")
+	})
+
+	results, err := client.ProjectsV1.BulkMove(ctx, "org-id", "target-id", "target-org-id", &BulkMoveOptions{DryRun: true})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []MoveResult{{ProjectID: "project-1", ProjectName: "first"}}, results)
+}
+
+func TestProjectsV1_BulkMove_emptyTargetID(t *testing.T) {
+	_, err := client.ProjectsV1.BulkMove(ctx, "org-id", "", "target-org-id", nil)
+
+	assert.ErrorContains(t, err, "target id must be supplied")
+}
@@ -0,0 +1,149 @@
+package snyk
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// PURL represents a package URL, a uniform way to identify a software package across ecosystems,
+// e.g. "pkg:npm/lodash@4.17.20" or "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1".
+//
+// See: https://github.com/package-url/purl-spec
+type PURL struct {
+	Type       string            // The package type or ecosystem, e.g. 'npm' or 'maven'.
+	Namespace  string            // The name prefix, e.g. a Maven groupId or an npm scope.
+	Name       string            // The name of the package.
+	Version    string            // The version of the package.
+	Qualifiers map[string]string // Extra qualifying data, e.g. 'arch' or 'distro'.
+	Subpath    string            // A subpath within the package.
+}
+
+// ParsePURL parses and validates a package URL.
+func ParsePURL(s string) (*PURL, error) {
+	remainder, found := strings.CutPrefix(s, "pkg:")
+	if !found {
+		return nil, fmt.Errorf("invalid purl %q: scheme must be 'pkg'", s)
+	}
+
+	purl := new(PURL)
+
+	remainder, subpath, _ := strings.Cut(remainder, "#")
+	purl.Subpath = strings.Trim(subpath, "/")
+
+	remainder, rawQualifiers, _ := strings.Cut(remainder, "?")
+	if rawQualifiers != "" {
+		purl.Qualifiers = make(map[string]string)
+		for _, qualifier := range strings.Split(rawQualifiers, "&") {
+			key, value, _ := strings.Cut(qualifier, "=")
+			decodedValue, err := url.PathUnescape(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid purl %q: %w", s, err)
+			}
+			if key == "" || decodedValue == "" {
+				continue
+			}
+			purl.Qualifiers[strings.ToLower(key)] = decodedValue
+		}
+	}
+
+	remainder = strings.Trim(remainder, "/")
+	purlType, remainder, _ := strings.Cut(remainder, "/")
+	purl.Type = strings.ToLower(purlType)
+
+	if i := strings.LastIndex(remainder, "@"); i != -1 {
+		version, err := url.PathUnescape(remainder[i+1:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid purl %q: %w", s, err)
+		}
+		purl.Version = version
+		remainder = remainder[:i]
+	}
+
+	segments := strings.Split(remainder, "/")
+	for i, segment := range segments {
+		decodedSegment, err := url.PathUnescape(segment)
+		if err != nil {
+			return nil, fmt.Errorf("invalid purl %q: %w", s, err)
+		}
+		segments[i] = decodedSegment
+	}
+	purl.Name = segments[len(segments)-1]
+	purl.Namespace = strings.Join(segments[:len(segments)-1], "/")
+
+	if err := purl.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid purl %q: %w", s, err)
+	}
+
+	return purl, nil
+}
+
+// Validate checks that the required components of the package URL are present and well-formed.
+func (p PURL) Validate() error {
+	if p.Type == "" {
+		return errors.New("type must be supplied")
+	}
+	for i, r := range p.Type {
+		isLetter := r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z'
+		isDigit := r >= '0' && r <= '9'
+		if i == 0 && !isLetter {
+			return errors.New("type must start with a letter")
+		}
+		if !isLetter && !isDigit && r != '.' && r != '+' && r != '-' {
+			return fmt.Errorf("type contains invalid character %q", r)
+		}
+	}
+	if p.Name == "" {
+		return errors.New("name must be supplied")
+	}
+	return nil
+}
+
+// String returns the canonical form of the package URL.
+func (p PURL) String() string {
+	var sb strings.Builder
+	sb.WriteString("pkg:")
+	sb.WriteString(strings.ToLower(p.Type))
+	sb.WriteByte('/')
+	if p.Namespace != "" {
+		for _, segment := range strings.Split(p.Namespace, "/") {
+			sb.WriteString(escapePURLComponent(segment))
+			sb.WriteByte('/')
+		}
+	}
+	sb.WriteString(escapePURLComponent(p.Name))
+	if p.Version != "" {
+		sb.WriteByte('@')
+		sb.WriteString(escapePURLComponent(p.Version))
+	}
+	if len(p.Qualifiers) > 0 {
+		keys := make([]string, 0, len(p.Qualifiers))
+		for key := range p.Qualifiers {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		for i, key := range keys {
+			if i == 0 {
+				sb.WriteByte('?')
+			} else {
+				sb.WriteByte('&')
+			}
+			sb.WriteString(strings.ToLower(key))
+			sb.WriteByte('=')
+			sb.WriteString(escapePURLComponent(p.Qualifiers[key]))
+		}
+	}
+	if p.Subpath != "" {
+		sb.WriteByte('#')
+		sb.WriteString(p.Subpath)
+	}
+	return sb.String()
+}
+
+// escapePURLComponent percent-encodes a single component, additionally encoding '@'
+// as it separates the version.
+func escapePURLComponent(s string) string {
+	return strings.ReplaceAll(url.PathEscape(s), "@", "%40")
+}
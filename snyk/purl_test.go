@@ -0,0 +1,75 @@
+package snyk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePURL(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		purl          string
+		expectedPURL  *PURL
+		canonicalPURL string
+		errorExpected bool
+	}{
+		"npm-package": {
+			purl:          "pkg:npm/lodash@4.17.20",
+			expectedPURL:  &PURL{Type: "npm", Name: "lodash", Version: "4.17.20"},
+			canonicalPURL: "pkg:npm/lodash@4.17.20",
+		},
+		"npm-scoped-package": {
+			purl:          "pkg:npm/%40angular/core@16.0.0",
+			expectedPURL:  &PURL{Type: "npm", Namespace: "@angular", Name: "core", Version: "16.0.0"},
+			canonicalPURL: "pkg:npm/%40angular/core@16.0.0",
+		},
+		"maven-package-with-qualifiers": {
+			purl: "pkg:Maven/org.apache.logging.log4j/log4j-core@2.14.1?type=jar&Classifier=sources",
+			expectedPURL: &PURL{
+				Type:       "maven",
+				Namespace:  "org.apache.logging.log4j",
+				Name:       "log4j-core",
+				Version:    "2.14.1",
+				Qualifiers: map[string]string{"classifier": "sources", "type": "jar"},
+			},
+			canonicalPURL: "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1?classifier=sources&type=jar",
+		},
+		"golang-package-with-subpath": {
+			purl:          "pkg:golang/github.com/google/go-querystring@v1.2.0#query",
+			expectedPURL:  &PURL{Type: "golang", Namespace: "github.com/google", Name: "go-querystring", Version: "v1.2.0", Subpath: "query"},
+			canonicalPURL: "pkg:golang/github.com/google/go-querystring@v1.2.0#query",
+		},
+		"package-without-version": {
+			purl:          "pkg:pypi/django",
+			expectedPURL:  &PURL{Type: "pypi", Name: "django"},
+			canonicalPURL: "pkg:pypi/django",
+		},
+		"error-missing-scheme": {
+			purl:          "npm/lodash@4.17.20",
+			errorExpected: true,
+		},
+		"error-missing-name": {
+			purl:          "pkg:npm/@4.17.20",
+			errorExpected: true,
+		},
+		"error-invalid-type": {
+			purl:          "pkg:1npm/lodash@4.17.20",
+			errorExpected: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			actualPURL, err := ParsePURL(test.purl)
+
+			if test.errorExpected {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedPURL, actualPURL)
+			assert.Equal(t, test.canonicalPURL, actualPURL.String())
+		})
+	}
+}
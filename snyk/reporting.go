@@ -0,0 +1,324 @@
+package snyk
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"net/http"
+	"time"
+)
+
+const reportingV1BasePath = "reporting"
+
+// ReportingServiceAPI is an interface for interacting with the reporting endpoints of the Snyk V1 API.
+//
+// Note: The reporting endpoints are restricted to a maximum date range and rate limited
+// by Snyk, prefer the aggregated counts over iterating all issues where possible.
+//
+// See: https://docs.snyk.io/snyk-api/reference/reporting-api-v1
+type ReportingServiceAPI interface {
+	// ListLatestIssues gets a page of issues currently present in the projects matching filters.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/reporting-api-v1#post-reporting-issues-latest
+	ListLatestIssues(ctx context.Context, opts *ListReportingIssuesOptions, filters *ReportingFilters) ([]ReportingIssueResult, *Response, error)
+
+	// AllLatestIssues returns an iterator to paginate over all issues currently present in the projects matching filters.
+	//
+	// This method handles the pagination logic internally by calling ListLatestIssues for each page.
+	// The return iterated can be used in a for...range loop to easily process all issues.
+	//
+	// Note: This function is experimental and its signature may change in a future release.
+	AllLatestIssues(ctx context.Context, opts *ListReportingIssuesOptions, filters *ReportingFilters) (iter.Seq2[ReportingIssueResult, *Response], func() error)
+
+	// ListIssues gets a page of issues present in the projects matching filters within the
+	// time range of ListReportingIssuesOptions.From and ListReportingIssuesOptions.To.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/reporting-api-v1#post-reporting-issues
+	ListIssues(ctx context.Context, opts *ListReportingIssuesOptions, filters *ReportingFilters) ([]ReportingIssueResult, *Response, error)
+
+	// AllIssues returns an iterator to paginate over all issues present in the projects matching
+	// filters within a time range.
+	//
+	// This method handles the pagination logic internally by calling ListIssues for each page.
+	// The return iterated can be used in a for...range loop to easily process all issues.
+	//
+	// Note: This function is experimental and its signature may change in a future release.
+	AllIssues(ctx context.Context, opts *ListReportingIssuesOptions, filters *ReportingFilters) (iter.Seq2[ReportingIssueResult, *Response], func() error)
+
+	// GetLatestIssueCounts provides the current number of issues in the projects matching filters.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/reporting-api-v1#post-reporting-counts-issues-latest
+	GetLatestIssueCounts(ctx context.Context, opts *ReportingCountsOptions, filters *ReportingFilters) ([]ReportingIssueCount, *Response, error)
+
+	// GetIssueCounts provides the number of issues per day in the projects matching filters
+	// within a time range.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/reporting-api-v1#post-reporting-counts-issues
+	GetIssueCounts(ctx context.Context, opts *ReportingCountsOptions, filters *ReportingFilters) ([]ReportingIssueCount, *Response, error)
+
+	// GetTestCounts provides the number of tests run in the organizations matching filters
+	// within a time range.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/reporting-api-v1#post-reporting-counts-tests
+	GetTestCounts(ctx context.Context, opts *ReportingCountsOptions, filters *ReportingFilters) ([]ReportingTestCount, *Response, error)
+
+	// GetProjectCounts provides the number of projects per day in the organizations matching
+	// filters within a time range.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/reporting-api-v1#post-reporting-counts-projects
+	GetProjectCounts(ctx context.Context, opts *ReportingCountsOptions, filters *ReportingFilters) ([]ReportingProjectCount, *Response, error)
+}
+
+// ReportingService handles communication with the reporting related methods of the Snyk V1 API.
+type ReportingService service
+
+var _ ReportingServiceAPI = (*ReportingService)(nil)
+
+// ReportingFilters restricts the projects, issues or tests aggregated in a report.
+// Unset fields don't filter.
+type ReportingFilters struct {
+	Fixable      *bool    `json:"fixable,omitempty"`      // If set, only include issues which are (not) fixable.
+	Ignored      *bool    `json:"ignored,omitempty"`      // If set, only include issues which are (not) ignored.
+	IsFixed      *bool    `json:"isFixed,omitempty"`      // If set, only include issues which are (not) fixed.
+	IsPrivate    *bool    `json:"isPrivate,omitempty"`    // If set, only include tests of (non) private projects, tests only.
+	IsUpgradable *bool    `json:"isUpgradable,omitempty"` // If set, only include issues which are (not) upgradable.
+	Languages    []string `json:"languages,omitempty"`    // The languages of the projects, e.g. 'node' or 'java'.
+	Orgs         []string `json:"orgs,omitempty"`         // The organization IDs, all accessible organizations if empty.
+	Patched      *bool    `json:"patched,omitempty"`      // If set, only include issues which are (not) patched.
+	Projects     []string `json:"projects,omitempty"`     // The project IDs.
+	Severity     []string `json:"severity,omitempty"`     // The severities, e.g. 'critical' or 'high'.
+	Types        []string `json:"types,omitempty"`        // The issue types, e.g. 'vuln' or 'license'.
+}
+
+type ListReportingIssuesOptions struct {
+	From    time.Time `url:"from,omitempty" layout:"2006-01-02"` // Start of the time range (inclusive), required by ListIssues.
+	GroupBy string    `url:"groupBy,omitempty"`                  // Set to 'issue' to get one result per issue instead of per issue and project.
+	Order   string    `url:"order,omitempty"`                    // The sort order, 'asc' or 'desc'.
+	Page    int       `url:"page,omitempty"`                     // The page of results, starting from 1.
+	PerPage int       `url:"perPage,omitempty"`                  // The number of results per page, at most 1000.
+	SortBy  string    `url:"sortBy,omitempty"`                   // The field to sort by, e.g. 'severity' or 'introducedDate'.
+	To      time.Time `url:"to,omitempty" layout:"2006-01-02"`   // End of the time range (inclusive), required by ListIssues.
+}
+
+type ReportingCountsOptions struct {
+	From    time.Time `url:"from,omitempty" layout:"2006-01-02"` // Start of the time range (inclusive), not used by GetLatestIssueCounts.
+	GroupBy string    `url:"groupBy,omitempty"`                  // The field to group by, e.g. 'severity' for issues or 'isPrivate' for tests.
+	To      time.Time `url:"to,omitempty" layout:"2006-01-02"`   // End of the time range (inclusive), not used by GetLatestIssueCounts.
+}
+
+// ReportingIssueResult represents an issue found in one or more projects.
+type ReportingIssueResult struct {
+	FixedDate      string             `json:"fixedDate,omitempty"`      // The date the issue was fixed, e.g. '2025-01-02'.
+	IntroducedDate string             `json:"introducedDate,omitempty"` // The date the issue was introduced.
+	IsFixed        bool               `json:"isFixed"`                  // Whether the issue is fixed.
+	Issue          *ReportingIssue    `json:"issue,omitempty"`          // The details of the issue.
+	PatchedDate    string             `json:"patchedDate,omitempty"`    // The date the issue was patched.
+	Project        *ReportingProject  `json:"project,omitempty"`        // The affected project, unless grouped by issue.
+	Projects       []ReportingProject `json:"projects,omitempty"`       // The affected projects, if grouped by issue.
+}
+
+type ReportingIssue struct {
+	CVSSScore       float64             `json:"cvssScore,omitempty"`
+	DisclosureTime  *time.Time          `json:"disclosureTime,omitempty"`
+	ExploitMaturity string              `json:"exploitMaturity,omitempty"`
+	ID              string              `json:"id"`                    // The issue identifier, e.g. 'SNYK-JS-LODASH-567746'.
+	Identifiers     map[string][]string `json:"identifiers,omitempty"` // The external identifiers, e.g. 'CVE' or 'CWE'.
+	IsIgnored       bool                `json:"isIgnored"`
+	IsPatchable     bool                `json:"isPatchable"`
+	IsPatched       bool                `json:"isPatched"`
+	IsPinnable      bool                `json:"isPinnable"`
+	IsUpgradable    bool                `json:"isUpgradable"`
+	Language        string              `json:"language,omitempty"`
+	Package         string              `json:"package,omitempty"`
+	PackageManager  string              `json:"packageManager,omitempty"`
+	PriorityScore   int                 `json:"priorityScore,omitempty"`
+	PublicationTime *time.Time          `json:"publicationTime,omitempty"`
+	Severity        string              `json:"severity"`
+	Title           string              `json:"title"`
+	Type            string              `json:"type"` // The issue type, e.g. 'vuln' or 'license'.
+	URL             string              `json:"url,omitempty"`
+	Version         string              `json:"version,omitempty"`
+}
+
+type ReportingProject struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	PackageManager string `json:"packageManager,omitempty"`
+	Source         string `json:"source,omitempty"`
+	TargetFile     string `json:"targetFile,omitempty"`
+	URL            string `json:"url,omitempty"`
+}
+
+// ReportingIssueCount represents the number of issues, of a single day if counted over time.
+type ReportingIssueCount struct {
+	Count    int                      `json:"count"`
+	Day      string                   `json:"day,omitempty"`      // The counted day, e.g. '2025-01-02'.
+	Severity *ReportingSeverityCounts `json:"severity,omitempty"` // The number of issues by severity, if grouped by severity.
+}
+
+type ReportingSeverityCounts struct {
+	Critical int `json:"critical"`
+	High     int `json:"high"`
+	Medium   int `json:"medium"`
+	Low      int `json:"low"`
+}
+
+// ReportingTestCount represents the number of tests run.
+type ReportingTestCount struct {
+	Count           int            `json:"count"`
+	IsPrivate       map[string]int `json:"isPrivate,omitempty"`       // The number of tests keyed by 'true' and 'false', if grouped by isPrivate.
+	IssuesPrevented map[string]int `json:"issuesPrevented,omitempty"` // The number of tests keyed by 'true' and 'false', if grouped by issuesPrevented.
+}
+
+// ReportingProjectCount represents the number of projects of a single day.
+type ReportingProjectCount struct {
+	Count int    `json:"count"`
+	Day   string `json:"day,omitempty"` // The counted day, e.g. '2025-01-02'.
+}
+
+type reportingFiltersRequest struct {
+	Filters *ReportingFilters `json:"filters"`
+}
+
+type reportingIssuesRoot struct {
+	Results []ReportingIssueResult `json:"results"`
+	Total   int                    `json:"total"`
+}
+
+type reportingCountsRoot[T any] struct {
+	Results []T `json:"results"`
+}
+
+func (r ReportingIssueResult) String() string { return Stringify(r) }
+
+func (s *ReportingService) ListLatestIssues(ctx context.Context, opts *ListReportingIssuesOptions, filters *ReportingFilters) ([]ReportingIssueResult, *Response, error) {
+	root, resp, err := s.listIssues(ctx, reportingV1BasePath+"/issues/latest", opts, filters)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Results, resp, nil
+}
+
+func (s *ReportingService) AllLatestIssues(ctx context.Context, opts *ListReportingIssuesOptions, filters *ReportingFilters) (iter.Seq2[ReportingIssueResult, *Response], func() error) {
+	return s.allIssues(ctx, reportingV1BasePath+"/issues/latest", opts, filters)
+}
+
+func (s *ReportingService) ListIssues(ctx context.Context, opts *ListReportingIssuesOptions, filters *ReportingFilters) ([]ReportingIssueResult, *Response, error) {
+	if opts == nil || opts.From.IsZero() || opts.To.IsZero() {
+		return nil, nil, errors.New("failed to list issues: from and to must be supplied")
+	}
+
+	root, resp, err := s.listIssues(ctx, reportingV1BasePath+"/issues/", opts, filters)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Results, resp, nil
+}
+
+func (s *ReportingService) AllIssues(ctx context.Context, opts *ListReportingIssuesOptions, filters *ReportingFilters) (iter.Seq2[ReportingIssueResult, *Response], func() error) {
+	if opts == nil || opts.From.IsZero() || opts.To.IsZero() {
+		return errorPaginator[ReportingIssueResult](errors.New("failed to list issues: from and to must be supplied"))
+	}
+
+	return s.allIssues(ctx, reportingV1BasePath+"/issues/", opts, filters)
+}
+
+func (s *ReportingService) GetLatestIssueCounts(ctx context.Context, opts *ReportingCountsOptions, filters *ReportingFilters) ([]ReportingIssueCount, *Response, error) {
+	return getReportingCounts[ReportingIssueCount](ctx, s.client, reportingV1BasePath+"/counts/issues/latest", opts, filters)
+}
+
+func (s *ReportingService) GetIssueCounts(ctx context.Context, opts *ReportingCountsOptions, filters *ReportingFilters) ([]ReportingIssueCount, *Response, error) {
+	if opts == nil || opts.From.IsZero() || opts.To.IsZero() {
+		return nil, nil, errors.New("failed to get issue counts: from and to must be supplied")
+	}
+
+	return getReportingCounts[ReportingIssueCount](ctx, s.client, reportingV1BasePath+"/counts/issues", opts, filters)
+}
+
+func (s *ReportingService) GetTestCounts(ctx context.Context, opts *ReportingCountsOptions, filters *ReportingFilters) ([]ReportingTestCount, *Response, error) {
+	if opts == nil || opts.From.IsZero() || opts.To.IsZero() {
+		return nil, nil, errors.New("failed to get test counts: from and to must be supplied")
+	}
+
+	return getReportingCounts[ReportingTestCount](ctx, s.client, reportingV1BasePath+"/counts/tests", opts, filters)
+}
+
+func (s *ReportingService) GetProjectCounts(ctx context.Context, opts *ReportingCountsOptions, filters *ReportingFilters) ([]ReportingProjectCount, *Response, error) {
+	if opts == nil || opts.From.IsZero() || opts.To.IsZero() {
+		return nil, nil, errors.New("failed to get project counts: from and to must be supplied")
+	}
+
+	return getReportingCounts[ReportingProjectCount](ctx, s.client, reportingV1BasePath+"/counts/projects", opts, filters)
+}
+
+func (s *ReportingService) listIssues(ctx context.Context, endpointURL string, opts *ListReportingIssuesOptions, filters *ReportingFilters) (*reportingIssuesRoot, *Response, error) {
+	if opts == nil {
+		opts = &ListReportingIssuesOptions{}
+	}
+	if filters == nil {
+		filters = &ReportingFilters{}
+	}
+
+	path, err := addOptions(endpointURL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodPost, s.client.v1BaseURL, path, reportingFiltersRequest{Filters: filters})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(reportingIssuesRoot)
+	resp, err := s.client.do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// allIssues iterates over the issue pages, starting from opts.Page, until Total results are read.
+func (s *ReportingService) allIssues(ctx context.Context, endpointURL string, opts *ListReportingIssuesOptions, filters *ReportingFilters) (iter.Seq2[ReportingIssueResult, *Response], func() error) {
+	if opts == nil {
+		opts = &ListReportingIssuesOptions{}
+	}
+
+	return newPagePaginator(ctx, opts.Page, opts.PerPage, func(page int) ([]ReportingIssueResult, int, *Response, error) {
+		opts.Page = page
+		root, resp, err := s.listIssues(ctx, endpointURL, opts, filters)
+		if err != nil {
+			return nil, 0, resp, err
+		}
+		return root.Results, root.Total, resp, nil
+	})
+}
+
+func getReportingCounts[T any](ctx context.Context, client *Client, endpointURL string, opts *ReportingCountsOptions, filters *ReportingFilters) ([]T, *Response, error) {
+	if opts == nil {
+		opts = &ReportingCountsOptions{}
+	}
+	if filters == nil {
+		filters = &ReportingFilters{}
+	}
+
+	path, err := addOptions(endpointURL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := client.prepareRequest(ctx, http.MethodPost, client.v1BaseURL, path, reportingFiltersRequest{Filters: filters})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(reportingCountsRoot[T])
+	resp, err := client.do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Results, resp, nil
+}
@@ -0,0 +1,205 @@
+package snyk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReporting_ListLatestIssues(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/reporting/issues/latest", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "issue", r.URL.Query().Get("groupBy"))
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]any{
+			"filters": map[string]any{"orgs": []any{"org-id"}, "severity": []any{"critical", "high"}, "ignored": false},
+		}, body)
+		_, _ = fmt.Fprint(w, `
+{
+  "results": [
+    {
+      "issue": {
+        "id": "SNYK-JS-LODASH-567746",
+        "title": "Prototype Pollution",
+        "type": "vuln",
+        "severity": "high",
+        "package": "lodash",
+        "version": "4.17.15",
+        "identifiers": { "CVE": [ "CVE-2020-8203" ] },
+        "isUpgradable": true
+      },
+      "projects": [ { "id": "project-id", "name": "goof", "packageManager": "npm" } ],
+      "isFixed": false,
+      "introducedDate": "2025-01-02"
+    }
+  ],
+  "total": 1
+}
+`)
+	})
+	expectedResults := []ReportingIssueResult{{
+		IntroducedDate: "2025-01-02",
+		Issue: &ReportingIssue{
+			ID:           "SNYK-JS-LODASH-567746",
+			Identifiers:  map[string][]string{"CVE": {"CVE-2020-8203"}},
+			IsUpgradable: true,
+			Package:      "lodash",
+			Severity:     "high",
+			Title:        "Prototype Pollution",
+			Type:         "vuln",
+			Version:      "4.17.15",
+		},
+		Projects: []ReportingProject{{ID: "project-id", Name: "goof", PackageManager: "npm"}},
+	}}
+
+	ignored := false
+	actualResults, _, err := client.Reporting.ListLatestIssues(ctx, &ListReportingIssuesOptions{GroupBy: "issue"}, &ReportingFilters{
+		Ignored:  &ignored,
+		Orgs:     []string{"org-id"},
+		Severity: []string{"critical", "high"},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedResults, actualResults)
+}
+
+func TestReporting_AllIssues(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/reporting/issues/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "2025-01-01", r.URL.Query().Get("from"))
+		assert.Equal(t, "2025-01-31", r.URL.Query().Get("to"))
+		assert.Equal(t, "2", r.URL.Query().Get("perPage"))
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		switch page {
+		case 1:
+			_, _ = fmt.Fprint(w, `{ "results": [ { "issue": { "id": "issue-1" } }, { "issue": { "id": "issue-2" } } ], "total": 3 }`)
+		case 2:
+			_, _ = fmt.Fprint(w, `{ "results": [ { "issue": { "id": "issue-3" } } ], "total": 3 }`)
+		default:
+			t.Errorf("unexpected page %d", page)
+		}
+	})
+
+	var issueIDs []string
+	results, errFn := client.Reporting.AllIssues(ctx, &ListReportingIssuesOptions{
+		From:    time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		To:      time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
+		PerPage: 2,
+	}, nil)
+	for result := range results {
+		issueIDs = append(issueIDs, result.Issue.ID)
+	}
+
+	assert.NoError(t, errFn())
+	assert.Equal(t, []string{"issue-1", "issue-2", "issue-3"}, issueIDs)
+}
+
+func TestReporting_ListIssues_emptyTimeRange(t *testing.T) {
+	_, _, err := client.Reporting.ListIssues(ctx, nil, nil)
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "from and to must be supplied")
+}
+
+func TestReporting_GetIssueCounts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/reporting/counts/issues", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "severity", r.URL.Query().Get("groupBy"))
+		_, _ = fmt.Fprint(w, `
+{
+  "results": [
+    { "day": "2025-01-01", "count": 3, "severity": { "critical": 0, "high": 1, "medium": 2, "low": 0 } },
+    { "day": "2025-01-02", "count": 1, "severity": { "critical": 0, "high": 1, "medium": 0, "low": 0 } }
+  ]
+}
+`)
+	})
+	expectedCounts := []ReportingIssueCount{
+		{Count: 3, Day: "2025-01-01", Severity: &ReportingSeverityCounts{High: 1, Medium: 2}},
+		{Count: 1, Day: "2025-01-02", Severity: &ReportingSeverityCounts{High: 1}},
+	}
+
+	actualCounts, _, err := client.Reporting.GetIssueCounts(ctx, &ReportingCountsOptions{
+		From:    time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		To:      time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+		GroupBy: "severity",
+	}, &ReportingFilters{Orgs: []string{"org-id"}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedCounts, actualCounts)
+}
+
+func TestReporting_GetLatestIssueCounts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/reporting/counts/issues/latest", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		_, _ = fmt.Fprint(w, `{ "results": [ { "count": 42 } ] }`)
+	})
+
+	counts, _, err := client.Reporting.GetLatestIssueCounts(ctx, nil, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []ReportingIssueCount{{Count: 42}}, counts)
+}
+
+func TestReporting_GetTestCounts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/reporting/counts/tests", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "isPrivate", r.URL.Query().Get("groupBy"))
+		_, _ = fmt.Fprint(w, `{ "results": [ { "count": 10, "isPrivate": { "true": 7, "false": 3 } } ] }`)
+	})
+
+	counts, _, err := client.Reporting.GetTestCounts(ctx, &ReportingCountsOptions{
+		From:    time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		To:      time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
+		GroupBy: "isPrivate",
+	}, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []ReportingTestCount{{Count: 10, IsPrivate: map[string]int{"true": 7, "false": 3}}}, counts)
+}
+
+func TestReporting_GetProjectCounts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/reporting/counts/projects", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		_, _ = fmt.Fprint(w, `{ "results": [ { "day": "2025-01-01", "count": 5 } ] }`)
+	})
+
+	counts, _, err := client.Reporting.GetProjectCounts(ctx, &ReportingCountsOptions{
+		From: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []ReportingProjectCount{{Count: 5, Day: "2025-01-01"}}, counts)
+}
+
+func TestReporting_GetProjectCounts_emptyTimeRange(t *testing.T) {
+	_, _, err := client.Reporting.GetProjectCounts(ctx, &ReportingCountsOptions{}, nil)
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "from and to must be supplied")
+}
@@ -0,0 +1,353 @@
+package snyk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	sbomTestsBasePath = orgsBasePath + "/%v/sbom_tests"
+	sbomAPIVersion    = "2024-10-15"
+
+	defaultSBOMTestPollInterval = 5 * time.Second
+)
+
+// SBOMServiceAPI is an interface for interacting with the SBOM endpoints of the Snyk API.
+//
+// See: https://docs.snyk.io/snyk-api/reference/sbom
+type SBOMServiceAPI interface {
+	// GetProjectSBOM generates an SBOM document of a project in the given format and streams it into w.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/sbom#get-orgs-org_id-projects-project_id-sbom
+	GetProjectSBOM(ctx context.Context, orgID, projectID string, format SBOMFormat, w io.Writer) (*Response, error)
+
+	// CreateSBOMTest submits an SBOM document (CycloneDX or SPDX JSON) to be tested for issues.
+	// The test runs asynchronously, use WaitForSBOMTest to wait for its completion.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/sbom#post-orgs-org_id-sbom_tests
+	CreateSBOMTest(ctx context.Context, orgID string, sbom json.RawMessage) (*SBOMTestJob, *Response, error)
+
+	// GetSBOMTestJob provides the status of an SBOM test job.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/sbom#get-orgs-org_id-sbom_tests-job_id
+	GetSBOMTestJob(ctx context.Context, orgID, jobID string) (*SBOMTestJob, *Response, error)
+
+	// WaitForSBOMTest polls an SBOM test job every pollInterval until it is finished or errored.
+	// If pollInterval is not positive, a default of 5 seconds is used. Cancel ctx to stop waiting.
+	WaitForSBOMTest(ctx context.Context, orgID, jobID string, pollInterval time.Duration) (*SBOMTestJob, *Response, error)
+
+	// GetSBOMTestResults provides the results of a finished SBOM test job.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/sbom#get-orgs-org_id-sbom_tests-job_id-results
+	GetSBOMTestResults(ctx context.Context, orgID, jobID string) (*SBOMTestResults, *Response, error)
+}
+
+// SBOMService handles communication with the SBOM related methods of the Snyk API.
+type SBOMService service
+
+var _ SBOMServiceAPI = (*SBOMService)(nil)
+
+// SBOMFormat defines the format of a generated SBOM document.
+type SBOMFormat string
+
+const (
+	SBOMFormatCycloneDX14JSON SBOMFormat = "cyclonedx1.4+json"
+	SBOMFormatCycloneDX14XML  SBOMFormat = "cyclonedx1.4+xml"
+	SBOMFormatCycloneDX15JSON SBOMFormat = "cyclonedx1.5+json"
+	SBOMFormatCycloneDX15XML  SBOMFormat = "cyclonedx1.5+xml"
+	SBOMFormatCycloneDX16JSON SBOMFormat = "cyclonedx1.6+json"
+	SBOMFormatCycloneDX16XML  SBOMFormat = "cyclonedx1.6+xml"
+	SBOMFormatSPDX23JSON      SBOMFormat = "spdx2.3+json"
+)
+
+// mediaType returns the media type of SBOM documents in the format f.
+func (f SBOMFormat) mediaType() string {
+	switch {
+	case strings.HasPrefix(string(f), "cyclonedx") && strings.HasSuffix(string(f), "+xml"):
+		return "application/vnd.cyclonedx+xml"
+	case strings.HasPrefix(string(f), "cyclonedx"):
+		return "application/vnd.cyclonedx+json"
+	default:
+		return defaultMediaType
+	}
+}
+
+type getProjectSBOMOptions struct {
+	BaseOptions
+	Format SBOMFormat `url:"format,omitempty"`
+}
+
+// SBOMTestJob represents an asynchronous SBOM test.
+type SBOMTestJob struct {
+	ID         string                 `json:"id"`                   // The SBOMTestJob identifier.
+	Type       string                 `json:"type"`                 // The resource type `sbom_tests`.
+	Attributes *SBOMTestJobAttributes `json:"attributes,omitempty"` // The SBOMTestJob resource data.
+}
+
+type SBOMTestJobAttributes struct {
+	Status SBOMTestStatus `json:"status"` // The status of the test.
+}
+
+// SBOMTestStatus defines the status of an SBOM test job.
+type SBOMTestStatus string
+
+const (
+	SBOMTestStatusError      SBOMTestStatus = "error"
+	SBOMTestStatusFinished   SBOMTestStatus = "finished"
+	SBOMTestStatusProcessing SBOMTestStatus = "processing"
+)
+
+// SBOMTestResults represents the outcome of a finished SBOM test.
+type SBOMTestResults struct {
+	Summary         *SBOMTestSummary
+	Vulnerabilities []SBOMTestVulnerability
+	Packages        []SBOMTestPackage
+}
+
+type SBOMTestSummary struct {
+	TotalIssues              int                     `json:"total_issues"`               // The number of issues found.
+	TotalLibraries           int                     `json:"total_libraries"`            // The number of tested libraries.
+	TotalVulnerableLibraries int                     `json:"total_vulnerable_libraries"` // The number of libraries with at least one issue.
+	Vulnerabilities          *SBOMTestSeverityCounts `json:"vulnerabilities,omitempty"`  // The number of issues per severity.
+}
+
+type SBOMTestSeverityCounts struct {
+	Critical int `json:"critical"`
+	High     int `json:"high"`
+	Medium   int `json:"medium"`
+	Low      int `json:"low"`
+}
+
+// SBOMTestVulnerability represents an issue found by an SBOM test.
+type SBOMTestVulnerability struct {
+	ID                     string           `json:"-"`                                  // The Snyk issue identifier, e.g. 'SNYK-JS-LODASH-567746'.
+	Title                  string           `json:"title"`                              // The title of the issue.
+	Description            string           `json:"description,omitempty"`              // The description of the issue in markdown.
+	EffectiveSeverityLevel string           `json:"effective_severity_level,omitempty"` // The severity of the issue, e.g. 'high'.
+	Problems               []SBOMTestSource `json:"problems,omitempty"`                 // The external identifiers of the issue, e.g. CVEs.
+}
+
+type SBOMTestSource struct {
+	ID     string `json:"id"`     // The identifier in the source, e.g. 'CVE-2020-8203'.
+	Source string `json:"source"` // The source of the identifier, e.g. 'NVD'.
+}
+
+// SBOMTestPackage represents a package tested by an SBOM test.
+type SBOMTestPackage struct {
+	ID      string `json:"-"`              // The package identifier.
+	Name    string `json:"name"`           // The name of the package.
+	PURL    string `json:"purl,omitempty"` // The package URL.
+	Version string `json:"version"`        // The version of the package.
+}
+
+type sbomTestJobRoot struct {
+	SBOMTestJob *SBOMTestJob `json:"data"`
+}
+
+type sbomTestResultsRoot struct {
+	Data struct {
+		Attributes struct {
+			Summary *SBOMTestSummary `json:"summary"`
+		} `json:"attributes"`
+	} `json:"data"`
+	Included []struct {
+		ID         string          `json:"id"`
+		Type       string          `json:"type"`
+		Attributes json.RawMessage `json:"attributes"`
+	} `json:"included"`
+}
+
+func (j SBOMTestJob) String() string { return Stringify(j) }
+
+func (r SBOMTestResults) String() string { return Stringify(r) }
+
+func (s *SBOMService) GetProjectSBOM(ctx context.Context, orgID, projectID string, format SBOMFormat, w io.Writer) (*Response, error) {
+	if orgID == "" {
+		return nil, errors.New("failed to get project sbom: org id must be supplied")
+	}
+	if projectID == "" {
+		return nil, errors.New("failed to get project sbom: project id must be supplied")
+	}
+	if w == nil {
+		return nil, errors.New("failed to get project sbom: writer must be supplied")
+	}
+
+	opts := getProjectSBOMOptions{BaseOptions: BaseOptions{Version: sbomAPIVersion}, Format: format}
+
+	path, err := addOptions(fmt.Sprintf(projectsBaseBase+"/%v/sbom", orgID, projectID), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, s.client.restBaseURL, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", format.mediaType())
+
+	return s.client.do(ctx, req, w)
+}
+
+func (s *SBOMService) CreateSBOMTest(ctx context.Context, orgID string, sbom json.RawMessage) (*SBOMTestJob, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to create sbom test: org id must be supplied")
+	}
+	if len(sbom) == 0 {
+		return nil, nil, errors.New("failed to create sbom test: payload must be supplied")
+	}
+
+	opts := BaseOptions{Version: sbomAPIVersion}
+
+	path, err := addOptions(fmt.Sprintf(sbomTestsBasePath, orgID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// inline jsonapi create payload to keep create function simple
+	var createRequestJSON struct {
+		Data struct {
+			Attributes struct {
+				SBOM json.RawMessage `json:"sbom"`
+			} `json:"attributes"`
+			Type string `json:"type"`
+		} `json:"data"`
+	}
+	createRequestJSON.Data.Attributes.SBOM = sbom
+	createRequestJSON.Data.Type = "sbom_test"
+
+	req, err := s.client.prepareRequest(ctx, http.MethodPost, s.client.restBaseURL, path, createRequestJSON)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(sbomTestJobRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.SBOMTestJob, resp, nil
+}
+
+func (s *SBOMService) GetSBOMTestJob(ctx context.Context, orgID, jobID string) (*SBOMTestJob, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to get sbom test job: org id must be supplied")
+	}
+	if jobID == "" {
+		return nil, nil, errors.New("failed to get sbom test job: job id must be supplied")
+	}
+
+	opts := BaseOptions{Version: sbomAPIVersion}
+
+	path, err := addOptions(fmt.Sprintf(sbomTestsBasePath+"/%v", orgID, jobID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, s.client.restBaseURL, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(sbomTestJobRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	// a finished job redirects (303 See Other) to its results, which the http client follows
+	if resp.Request != nil && strings.HasSuffix(resp.Request.URL.Path, "/results") {
+		return &SBOMTestJob{
+			ID:         jobID,
+			Type:       "sbom_tests",
+			Attributes: &SBOMTestJobAttributes{Status: SBOMTestStatusFinished},
+		}, resp, nil
+	}
+
+	return root.SBOMTestJob, resp, nil
+}
+
+func (s *SBOMService) WaitForSBOMTest(ctx context.Context, orgID, jobID string, pollInterval time.Duration) (*SBOMTestJob, *Response, error) {
+	if pollInterval <= 0 {
+		pollInterval = defaultSBOMTestPollInterval
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		job, resp, err := s.GetSBOMTestJob(ctx, orgID, jobID)
+		if err != nil {
+			return nil, resp, err
+		}
+		if job != nil && job.Attributes != nil {
+			switch job.Attributes.Status {
+			case SBOMTestStatusFinished:
+				return job, resp, nil
+			case SBOMTestStatusError:
+				return job, resp, fmt.Errorf("sbom test job %v failed", jobID)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return job, resp, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *SBOMService) GetSBOMTestResults(ctx context.Context, orgID, jobID string) (*SBOMTestResults, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to get sbom test results: org id must be supplied")
+	}
+	if jobID == "" {
+		return nil, nil, errors.New("failed to get sbom test results: job id must be supplied")
+	}
+
+	opts := BaseOptions{Version: sbomAPIVersion}
+
+	path, err := addOptions(fmt.Sprintf(sbomTestsBasePath+"/%v/results", orgID, jobID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, s.client.restBaseURL, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(sbomTestResultsRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	results := &SBOMTestResults{Summary: root.Data.Attributes.Summary}
+	for _, included := range root.Included {
+		switch included.Type {
+		case "vulnerabilities":
+			var vulnerability SBOMTestVulnerability
+			if err := json.Unmarshal(included.Attributes, &vulnerability); err != nil {
+				return nil, resp, err
+			}
+			vulnerability.ID = included.ID
+			results.Vulnerabilities = append(results.Vulnerabilities, vulnerability)
+		case "packages":
+			var pkg SBOMTestPackage
+			if err := json.Unmarshal(included.Attributes, &pkg); err != nil {
+				return nil, resp, err
+			}
+			pkg.ID = included.ID
+			results.Packages = append(results.Packages, pkg)
+		}
+	}
+
+	return results, resp, nil
+}
@@ -0,0 +1,162 @@
+package snyk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSBOM_GetProjectSBOM(t *testing.T) {
+	setup()
+	defer teardown()
+
+	sbomDocument := `{"bomFormat":"CycloneDX","specVersion":"1.5","components":[]}`
+	mux.HandleFunc("/orgs/org-id/projects/project-id/sbom", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "cyclonedx1.5+json", r.URL.Query().Get("format"))
+		assert.Equal(t, "application/vnd.cyclonedx+json", r.Header.Get("Accept"))
+		_, _ = fmt.Fprint(w, sbomDocument)
+	})
+
+	buf := new(bytes.Buffer)
+	_, err := client.SBOM.GetProjectSBOM(ctx, "org-id", "project-id", SBOMFormatCycloneDX15JSON, buf)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sbomDocument, buf.String())
+}
+
+func TestSBOM_GetProjectSBOM_emptyWriter(t *testing.T) {
+	_, err := client.SBOM.GetProjectSBOM(ctx, "org-id", "project-id", SBOMFormatSPDX23JSON, nil)
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "writer must be supplied")
+}
+
+func TestSBOM_CreateSBOMTest(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/sbom_tests", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, map[string]any{
+			"data": map[string]any{
+				"attributes": map[string]any{"sbom": map[string]any{"bomFormat": "CycloneDX"}},
+				"type":       "sbom_test",
+			},
+		}, body)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `{ "data": { "id": "job-id", "type": "sbom_tests", "attributes": { "status": "processing" } } }`)
+	})
+	expectedJob := &SBOMTestJob{ID: "job-id", Type: "sbom_tests", Attributes: &SBOMTestJobAttributes{Status: SBOMTestStatusProcessing}}
+
+	actualJob, _, err := client.SBOM.CreateSBOMTest(ctx, "org-id", json.RawMessage(`{"bomFormat":"CycloneDX"}`))
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedJob, actualJob)
+}
+
+func TestSBOM_WaitForSBOMTest(t *testing.T) {
+	setup()
+	defer teardown()
+
+	polls := 0
+	mux.HandleFunc("/orgs/org-id/sbom_tests/job-id", func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		if polls < 3 {
+			_, _ = fmt.Fprint(w, `{ "data": { "id": "job-id", "type": "sbom_tests", "attributes": { "status": "processing" } } }`)
+			return
+		}
+		http.Redirect(w, r, "/orgs/org-id/sbom_tests/job-id/results?version=2024-10-15", http.StatusSeeOther)
+	})
+	mux.HandleFunc("/orgs/org-id/sbom_tests/job-id/results", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{ "data": { "id": "job-id", "type": "sbom_tests", "attributes": {} } }`)
+	})
+
+	actualJob, _, err := client.SBOM.WaitForSBOMTest(ctx, "org-id", "job-id", time.Millisecond)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, polls)
+	assert.Equal(t, SBOMTestStatusFinished, actualJob.Attributes.Status)
+}
+
+func TestSBOM_WaitForSBOMTest_jobError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/sbom_tests/job-id", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{ "data": { "id": "job-id", "type": "sbom_tests", "attributes": { "status": "error" } } }`)
+	})
+
+	_, _, err := client.SBOM.WaitForSBOMTest(ctx, "org-id", "job-id", time.Millisecond)
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "sbom test job job-id failed")
+}
+
+func TestSBOM_GetSBOMTestResults(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/sbom_tests/job-id/results", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		_, _ = fmt.Fprint(w, `
+{
+  "data": {
+    "id": "job-id",
+    "type": "sbom_tests",
+    "attributes": {
+      "summary": {
+        "total_issues": 1,
+        "total_libraries": 2,
+        "total_vulnerable_libraries": 1,
+        "vulnerabilities": { "critical": 0, "high": 1, "medium": 0, "low": 0 }
+      }
+    }
+  },
+  "included": [
+    {
+      "id": "SNYK-JS-LODASH-567746",
+      "type": "vulnerabilities",
+      "attributes": {
+        "title": "Prototype Pollution",
+        "effective_severity_level": "high",
+        "problems": [ { "id": "CVE-2020-8203", "source": "NVD" } ]
+      }
+    },
+    {
+      "id": "lodash@4.17.15",
+      "type": "packages",
+      "attributes": { "name": "lodash", "version": "4.17.15", "purl": "pkg:npm/lodash@4.17.15" }
+    }
+  ]
+}
+`)
+	})
+	expectedResults := &SBOMTestResults{
+		Summary: &SBOMTestSummary{
+			TotalIssues:              1,
+			TotalLibraries:           2,
+			TotalVulnerableLibraries: 1,
+			Vulnerabilities:          &SBOMTestSeverityCounts{High: 1},
+		},
+		Vulnerabilities: []SBOMTestVulnerability{{
+			ID:                     "SNYK-JS-LODASH-567746",
+			Title:                  "Prototype Pollution",
+			EffectiveSeverityLevel: "high",
+			Problems:               []SBOMTestSource{{ID: "CVE-2020-8203", Source: "NVD"}},
+		}},
+		Packages: []SBOMTestPackage{{ID: "lodash@4.17.15", Name: "lodash", PURL: "pkg:npm/lodash@4.17.15", Version: "4.17.15"}},
+	}
+
+	actualResults, _, err := client.SBOM.GetSBOMTestResults(ctx, "org-id", "job-id")
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedResults, actualResults)
+}
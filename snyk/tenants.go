@@ -1,11 +1,88 @@
 package snyk
 
-import "time"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+	"time"
+)
 
 const (
-	tenantsBasePath = "tenants"
+	tenantsBasePath   = "tenants"
+	tenantsAPIVersion = "2024-10-15"
 )
 
+// TenantsServiceAPI is an interface for interacting with the tenants endpoints of the Snyk API.
+//
+// See: https://docs.snyk.io/snyk-api/reference/tenants
+type TenantsServiceAPI interface {
+	// List gets a paginated list of all tenants you are a member of.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/tenants#get-tenants
+	List(ctx context.Context, opts *ListOptions) ([]Tenant, *Response, error)
+
+	// All returns an iterator to paginate over all tenants you are a member of.
+	//
+	// This method handles the pagination logic internally by calling List for each page.
+	// The return iterated can be used in a for...range loop to easily process all tenants.
+	//
+	// Note: This function is experimental and its signature may change in a future release.
+	All(ctx context.Context, opts *ListOptions) (iter.Seq2[Tenant, *Response], func() error)
+
+	// Get provides the full details of a tenant.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/tenants#get-tenants-tenant_id
+	Get(ctx context.Context, tenantID string) (*Tenant, *Response, error)
+
+	// Update changes the details of a tenant.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/tenants#patch-tenants-tenant_id
+	Update(ctx context.Context, tenantID string, updateRequest *TenantUpdateRequest) (*Tenant, *Response, error)
+
+	// ListMemberships gets a paginated list of all memberships of a tenant.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/tenants#get-tenants-tenant_id-memberships
+	ListMemberships(ctx context.Context, tenantID string, opts *ListTenantMembershipsOptions) ([]TenantMembership, *Response, error)
+
+	// AllMemberships returns an iterator to paginate over all memberships of a tenant.
+	//
+	// This method handles the pagination logic internally by calling ListMemberships for each page.
+	// The return iterated can be used in a for...range loop to easily process all memberships.
+	//
+	// Note: This function is experimental and its signature may change in a future release.
+	AllMemberships(ctx context.Context, tenantID string, opts *ListTenantMembershipsOptions) (iter.Seq2[TenantMembership, *Response], func() error)
+
+	// UpdateMembership changes the role of a tenant membership.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/tenants#patch-tenants-tenant_id-memberships-membership_id
+	UpdateMembership(ctx context.Context, tenantID, membershipID string, updateRequest *TenantMembershipUpdateRequest) (*Response, error)
+
+	// DeleteMembership removes a user from a tenant.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/tenants#delete-tenants-tenant_id-memberships-membership_id
+	DeleteMembership(ctx context.Context, tenantID, membershipID string) (*Response, error)
+
+	// ListRoles gets a paginated list of all roles available in a tenant.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/tenants#get-tenants-tenant_id-roles
+	ListRoles(ctx context.Context, tenantID string, opts *ListOptions) ([]TenantRole, *Response, error)
+
+	// AllRoles returns an iterator to paginate over all roles available in a tenant.
+	//
+	// This method handles the pagination logic internally by calling ListRoles for each page.
+	// The return iterated can be used in a for...range loop to easily process all roles.
+	//
+	// Note: This function is experimental and its signature may change in a future release.
+	AllRoles(ctx context.Context, tenantID string, opts *ListOptions) (iter.Seq2[TenantRole, *Response], func() error)
+}
+
+// TenantsService handles communication with the tenant related methods of the Snyk API.
+type TenantsService service
+
+var _ TenantsServiceAPI = (*TenantsService)(nil)
+
 // Tenant represents a Snyk Tenant.
 //
 // See: https://docs.snyk.io/discover-snyk/getting-started/glossary#tenant
@@ -22,6 +99,349 @@ type TenantAttributes struct {
 	UpdatedAt time.Time `json:"updated_at"` // The time the tenant was last modified.
 }
 
+type TenantUpdateRequest struct {
+	Name string
+}
+
+// TenantMembership represents the membership of a user in a tenant.
+type TenantMembership struct {
+	ID            string                         `json:"id"`                      // The TenantMembership identifier.
+	Type          string                         `json:"type"`                    // The resource type `tenant_membership`.
+	Attributes    *TenantMembershipAttributes    `json:"attributes,omitempty"`    // The TenantMembership resource data.
+	Relationships *TenantMembershipRelationships `json:"relationships,omitempty"` // The relationships object describing the member and the role.
+}
+
+type TenantMembershipAttributes struct {
+	CreatedAt time.Time `json:"created_at,omitempty"` // The time the membership was created.
+}
+
+type TenantMembershipRelationships struct {
+	Role   *tenantRoleRoot `json:"role,omitempty"`
+	Tenant *tenantRoot     `json:"tenant,omitempty"`
+	User   *userRoot       `json:"user,omitempty"`
+}
+
+type ListTenantMembershipsOptions struct {
+	ListOptions
+	Email     string `url:"email,omitempty"`      // If set, only return memberships of the user with this email.
+	RoleName  string `url:"role_name,omitempty"`  // If set, only return memberships with this role.
+	SortBy    string `url:"sort_by,omitempty"`    // The field to sort by, e.g. 'username' or 'email'.
+	SortOrder string `url:"sort_order,omitempty"` // The sort order, 'ASC' or 'DESC'.
+}
+
+type TenantMembershipUpdateRequest struct {
+	RoleID string // The ID of the new tenant role, see ListRoles.
+}
+
+// TenantRole represents a role which can be assigned to the members of a tenant.
+type TenantRole struct {
+	ID         string                `json:"id"`                   // The TenantRole identifier.
+	Type       string                `json:"type"`                 // The resource type `tenant_role`.
+	Attributes *TenantRoleAttributes `json:"attributes,omitempty"` // The TenantRole resource data.
+}
+
+type TenantRoleAttributes struct {
+	CreatedAt   time.Time `json:"created_at,omitempty"`  // The time the role was created.
+	Description string    `json:"description,omitempty"` // The description of the role.
+	Name        string    `json:"name"`                  // The display name of the role.
+	Permissions []string  `json:"permissions,omitempty"` // The permissions granted by the role.
+}
+
 type tenantRoot struct {
 	Data *Tenant `json:"data,omitempty"`
 }
+
+type tenantsRoot struct {
+	Tenants []Tenant        `json:"data"`
+	Links   *PaginatedLinks `json:"links,omitempty"`
+}
+
+type tenantMembershipsRoot struct {
+	Memberships []TenantMembership `json:"data"`
+	Links       *PaginatedLinks    `json:"links,omitempty"`
+}
+
+type tenantRoleRoot struct {
+	Data *TenantRole `json:"data,omitempty"`
+}
+
+type tenantRolesRoot struct {
+	Roles []TenantRole    `json:"data"`
+	Links *PaginatedLinks `json:"links,omitempty"`
+}
+
+func (t Tenant) String() string { return Stringify(t) }
+
+func (m TenantMembership) String() string { return Stringify(m) }
+
+func (r TenantRole) String() string { return Stringify(r) }
+
+func (s *TenantsService) List(ctx context.Context, opts *ListOptions) ([]Tenant, *Response, error) {
+	if opts == nil {
+		opts = &ListOptions{}
+	}
+	if opts.Version == "" {
+		opts.Version = tenantsAPIVersion
+	}
+
+	path, err := addOptions(tenantsBasePath, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, s.client.restBaseURL, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(tenantsRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if l := root.Links; l != nil {
+		resp.Links = l
+	}
+
+	return root.Tenants, resp, nil
+}
+
+func (s *TenantsService) All(ctx context.Context, opts *ListOptions) (iter.Seq2[Tenant, *Response], func() error) {
+	if opts == nil {
+		opts = &ListOptions{}
+	}
+	if opts.Version == "" {
+		opts.Version = tenantsAPIVersion
+	}
+	return newPaginator[Tenant](ctx, s.client, s.client.restBaseURL, tenantsBasePath, opts)
+}
+
+func (s *TenantsService) Get(ctx context.Context, tenantID string) (*Tenant, *Response, error) {
+	if tenantID == "" {
+		return nil, nil, errors.New("failed to get tenant: id must be supplied")
+	}
+
+	opts := &BaseOptions{Version: tenantsAPIVersion}
+
+	path, err := addOptions(fmt.Sprintf("%v/%v", tenantsBasePath, tenantID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, s.client.restBaseURL, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(tenantRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Data, resp, nil
+}
+
+func (s *TenantsService) Update(ctx context.Context, tenantID string, updateRequest *TenantUpdateRequest) (*Tenant, *Response, error) {
+	if tenantID == "" {
+		return nil, nil, errors.New("failed to update tenant: id must be supplied")
+	}
+	if updateRequest == nil {
+		return nil, nil, errors.New("failed to update tenant: payload must be supplied")
+	}
+
+	opts := &BaseOptions{Version: tenantsAPIVersion}
+
+	path, err := addOptions(fmt.Sprintf("%v/%v", tenantsBasePath, tenantID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// inline jsonapi update payload to keep update function simple
+	var updateRequestJSON struct {
+		Data struct {
+			Attributes struct {
+				Name string `json:"name"`
+			} `json:"attributes"`
+			ID   string `json:"id"`
+			Type string `json:"type"`
+		} `json:"data"`
+	}
+	updateRequestJSON.Data.Attributes.Name = updateRequest.Name
+	updateRequestJSON.Data.ID = tenantID
+	updateRequestJSON.Data.Type = "tenant"
+
+	req, err := s.client.prepareRequest(ctx, http.MethodPatch, s.client.restBaseURL, path, updateRequestJSON)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(tenantRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Data, resp, nil
+}
+
+func (s *TenantsService) ListMemberships(ctx context.Context, tenantID string, opts *ListTenantMembershipsOptions) ([]TenantMembership, *Response, error) {
+	if tenantID == "" {
+		return nil, nil, errors.New("failed to list tenant memberships: tenant id must be supplied")
+	}
+
+	if opts == nil {
+		opts = &ListTenantMembershipsOptions{}
+	}
+	if opts.Version == "" {
+		opts.Version = tenantsAPIVersion
+	}
+
+	path, err := addOptions(fmt.Sprintf("%v/%v/memberships", tenantsBasePath, tenantID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, s.client.restBaseURL, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(tenantMembershipsRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if l := root.Links; l != nil {
+		resp.Links = l
+	}
+
+	return root.Memberships, resp, nil
+}
+
+func (s *TenantsService) AllMemberships(ctx context.Context, tenantID string, opts *ListTenantMembershipsOptions) (iter.Seq2[TenantMembership, *Response], func() error) {
+	if tenantID == "" {
+		return errorPaginator[TenantMembership](errors.New("failed to list tenant memberships: tenant id must be supplied"))
+	}
+
+	if opts == nil {
+		opts = &ListTenantMembershipsOptions{}
+	}
+	if opts.Version == "" {
+		opts.Version = tenantsAPIVersion
+	}
+	return newPaginator[TenantMembership](ctx, s.client, s.client.restBaseURL, fmt.Sprintf("%v/%v/memberships", tenantsBasePath, tenantID), opts)
+}
+
+func (s *TenantsService) UpdateMembership(ctx context.Context, tenantID, membershipID string, updateRequest *TenantMembershipUpdateRequest) (*Response, error) {
+	if tenantID == "" {
+		return nil, errors.New("failed to update tenant membership: tenant id must be supplied")
+	}
+	if membershipID == "" {
+		return nil, errors.New("failed to update tenant membership: membership id must be supplied")
+	}
+	if updateRequest == nil || updateRequest.RoleID == "" {
+		return nil, errors.New("failed to update tenant membership: role id must be supplied")
+	}
+
+	opts := &BaseOptions{Version: tenantsAPIVersion}
+
+	path, err := addOptions(fmt.Sprintf("%v/%v/memberships/%v", tenantsBasePath, tenantID, membershipID), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// inline jsonapi update payload to keep update function simple
+	var updateRequestJSON struct {
+		Data struct {
+			ID            string `json:"id"`
+			Relationships struct {
+				Role struct {
+					Data ResourceIdentifier `json:"data"`
+				} `json:"role"`
+			} `json:"relationships"`
+			Type string `json:"type"`
+		} `json:"data"`
+	}
+	updateRequestJSON.Data.ID = membershipID
+	updateRequestJSON.Data.Relationships.Role.Data = ResourceIdentifier{ID: updateRequest.RoleID, Type: "tenant_role"}
+	updateRequestJSON.Data.Type = "tenant_membership"
+
+	req, err := s.client.prepareRequest(ctx, http.MethodPatch, s.client.restBaseURL, path, updateRequestJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
+
+func (s *TenantsService) DeleteMembership(ctx context.Context, tenantID, membershipID string) (*Response, error) {
+	if tenantID == "" {
+		return nil, errors.New("failed to delete tenant membership: tenant id must be supplied")
+	}
+	if membershipID == "" {
+		return nil, errors.New("failed to delete tenant membership: membership id must be supplied")
+	}
+
+	opts := &BaseOptions{Version: tenantsAPIVersion}
+
+	path, err := addOptions(fmt.Sprintf("%v/%v/memberships/%v", tenantsBasePath, tenantID, membershipID), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodDelete, s.client.restBaseURL, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
+
+func (s *TenantsService) ListRoles(ctx context.Context, tenantID string, opts *ListOptions) ([]TenantRole, *Response, error) {
+	if tenantID == "" {
+		return nil, nil, errors.New("failed to list tenant roles: tenant id must be supplied")
+	}
+
+	if opts == nil {
+		opts = &ListOptions{}
+	}
+	if opts.Version == "" {
+		opts.Version = tenantsAPIVersion
+	}
+
+	path, err := addOptions(fmt.Sprintf("%v/%v/roles", tenantsBasePath, tenantID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, s.client.restBaseURL, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(tenantRolesRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if l := root.Links; l != nil {
+		resp.Links = l
+	}
+
+	return root.Roles, resp, nil
+}
+
+func (s *TenantsService) AllRoles(ctx context.Context, tenantID string, opts *ListOptions) (iter.Seq2[TenantRole, *Response], func() error) {
+	if tenantID == "" {
+		return errorPaginator[TenantRole](errors.New("failed to list tenant roles: tenant id must be supplied"))
+	}
+
+	if opts == nil {
+		opts = &ListOptions{}
+	}
+	if opts.Version == "" {
+		opts.Version = tenantsAPIVersion
+	}
+	return newPaginator[TenantRole](ctx, s.client, s.client.restBaseURL, fmt.Sprintf("%v/%v/roles", tenantsBasePath, tenantID), opts)
+}
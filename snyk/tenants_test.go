@@ -0,0 +1,206 @@
+package snyk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTenants_All(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/tenants", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		if r.URL.Query().Get("starting_after") == "" {
+			_, _ = fmt.Fprint(w, `{
+  "data": [ { "id": "tenant-1", "type": "tenant", "attributes": { "name": "First Tenant", "slug": "first-tenant" } } ],
+  "links": { "next": "/tenants?starting_after=cursor-1" }
+}`)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{ "data": [ { "id": "tenant-2", "type": "tenant", "attributes": { "name": "Second Tenant" } } ], "links": {} }`)
+	})
+
+	var tenantIDs []string
+	tenants, errFn := client.Tenants.All(ctx, nil)
+	for tenant := range tenants {
+		tenantIDs = append(tenantIDs, tenant.ID)
+	}
+
+	assert.NoError(t, errFn())
+	assert.Equal(t, []string{"tenant-1", "tenant-2"}, tenantIDs)
+}
+
+func TestTenants_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/tenants/tenant-id", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		_, _ = fmt.Fprint(w, `
+{
+  "data": {
+    "id": "tenant-id",
+    "type": "tenant",
+    "attributes": {
+      "created_at": "2024-01-02T03:04:05Z",
+      "name": "My Tenant",
+      "slug": "my-tenant",
+      "updated_at": "2024-01-02T03:04:05Z"
+    }
+  }
+}
+`)
+	})
+	expectedTenant := &Tenant{
+		ID:   "tenant-id",
+		Type: "tenant",
+		Attributes: &TenantAttributes{
+			CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			Name:      "My Tenant",
+			Slug:      "my-tenant",
+			UpdatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+	}
+
+	actualTenant, _, err := client.Tenants.Get(ctx, "tenant-id")
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedTenant, actualTenant)
+}
+
+func TestTenants_Get_emptyTenantID(t *testing.T) {
+	_, _, err := client.Tenants.Get(ctx, "")
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "id must be supplied")
+}
+
+func TestTenants_Update(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/tenants/tenant-id", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]any{
+			"data": map[string]any{"id": "tenant-id", "type": "tenant", "attributes": map[string]any{"name": "Renamed Tenant"}},
+		}, body)
+		_, _ = fmt.Fprint(w, `{ "data": { "id": "tenant-id", "type": "tenant", "attributes": { "name": "Renamed Tenant" } } }`)
+	})
+
+	tenant, _, err := client.Tenants.Update(ctx, "tenant-id", &TenantUpdateRequest{Name: "Renamed Tenant"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Renamed Tenant", tenant.Attributes.Name)
+}
+
+func TestTenants_ListMemberships(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/tenants/tenant-id/memberships", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "jane@example.com", r.URL.Query().Get("email"))
+		_, _ = fmt.Fprint(w, `
+{
+  "data": [
+    {
+      "id": "membership-id",
+      "type": "tenant_membership",
+      "attributes": { "created_at": "2024-01-02T03:04:05Z" },
+      "relationships": {
+        "role": { "data": { "id": "role-id", "type": "tenant_role", "attributes": { "name": "Tenant Admin" } } },
+        "user": { "data": { "id": "user-id", "type": "user", "attributes": { "name": "Jane", "email": "jane@example.com" } } }
+      }
+    }
+  ],
+  "links": {}
+}
+`)
+	})
+	expectedMemberships := []TenantMembership{{
+		ID:         "membership-id",
+		Type:       "tenant_membership",
+		Attributes: &TenantMembershipAttributes{CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+		Relationships: &TenantMembershipRelationships{
+			Role: &tenantRoleRoot{Data: &TenantRole{ID: "role-id", Type: "tenant_role", Attributes: &TenantRoleAttributes{Name: "Tenant Admin"}}},
+			User: &userRoot{User: &User{ID: "user-id", Type: "user", Attributes: &UserAttributes{Name: "Jane", Email: "jane@example.com"}}},
+		},
+	}}
+
+	actualMemberships, _, err := client.Tenants.ListMemberships(ctx, "tenant-id", &ListTenantMembershipsOptions{Email: "jane@example.com"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedMemberships, actualMemberships)
+}
+
+func TestTenants_UpdateMembership(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/tenants/tenant-id/memberships/membership-id", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]any{
+			"data": map[string]any{
+				"id":            "membership-id",
+				"type":          "tenant_membership",
+				"relationships": map[string]any{"role": map[string]any{"data": map[string]any{"id": "role-id", "type": "tenant_role"}}},
+			},
+		}, body)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Tenants.UpdateMembership(ctx, "tenant-id", "membership-id", &TenantMembershipUpdateRequest{RoleID: "role-id"})
+
+	assert.NoError(t, err)
+}
+
+func TestTenants_UpdateMembership_emptyRoleID(t *testing.T) {
+	_, err := client.Tenants.UpdateMembership(ctx, "tenant-id", "membership-id", &TenantMembershipUpdateRequest{})
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "role id must be supplied")
+}
+
+func TestTenants_DeleteMembership(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/tenants/tenant-id/memberships/membership-id", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Tenants.DeleteMembership(ctx, "tenant-id", "membership-id")
+
+	assert.NoError(t, err)
+}
+
+func TestTenants_ListRoles(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/tenants/tenant-id/roles", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		_, _ = fmt.Fprint(w, `{ "data": [ { "id": "role-id", "type": "tenant_role", "attributes": { "name": "Tenant Admin", "description": "Full access" } } ], "links": {} }`)
+	})
+	expectedRoles := []TenantRole{{
+		ID:         "role-id",
+		Type:       "tenant_role",
+		Attributes: &TenantRoleAttributes{Description: "Full access", Name: "Tenant Admin"},
+	}}
+
+	actualRoles, _, err := client.Tenants.ListRoles(ctx, "tenant-id", nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedRoles, actualRoles)
+}
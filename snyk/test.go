@@ -0,0 +1,521 @@
+package snyk
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+)
+
+const (
+	testV1BasePath    = "test"
+	monitorV1BasePath = "monitor"
+
+	depGraphSchemaVersion = "1.2.0"
+)
+
+// DepGraphRootNodeID is the node ID of the root package in a DepGraph built by DepGraphBuilder.
+const DepGraphRootNodeID = "root-node"
+
+// Errors reported by DepGraph.Validate and DepGraphBuilder for invalid dependency graphs.
+var (
+	ErrDepGraphCycle         = errors.New("dependency cycle")
+	ErrDepGraphDuplicateNode = errors.New("duplicate node")
+	ErrDepGraphDuplicatePkg  = errors.New("duplicate package")
+	ErrDepGraphInvalidPkgID  = errors.New("invalid package id")
+	ErrDepGraphUnknownNode   = errors.New("unknown node")
+	ErrDepGraphUnknownPkg    = errors.New("unknown package")
+)
+
+// TestServiceAPI is an interface for interacting with the test and monitor endpoints of the Snyk V1 API.
+//
+// See: https://docs.snyk.io/snyk-api/reference/test-v1
+type TestServiceAPI interface {
+	// TestDepGraph tests a dependency graph for issues. If orgID is empty, the default
+	// Organization of the user is used.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/test-v1#post-test-dep-graph
+	TestDepGraph(ctx context.Context, orgID string, depGraph *DepGraph) (*DepGraphTestResult, *Response, error)
+
+	// MonitorDepGraph creates a project from a dependency graph, or takes a new snapshot
+	// of the project if it is already monitored. If orgID is empty, the default
+	// Organization of the user is used.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/monitor-v1#post-monitor-dep-graph
+	MonitorDepGraph(ctx context.Context, orgID string, depGraph *DepGraph, meta *DepGraphMonitorMeta) (*DepGraphMonitorResult, *Response, error)
+}
+
+// TestService handles communication with the test and monitor related methods of the Snyk V1 API.
+type TestService service
+
+var _ TestServiceAPI = (*TestService)(nil)
+
+// DepGraph represents a dependency graph in the Snyk dep-graph v1 format.
+type DepGraph struct {
+	SchemaVersion string             `json:"schemaVersion"` // The version of the dep-graph format, e.g. `1.2.0`.
+	PkgManager    DepGraphPkgManager `json:"pkgManager"`    // The package manager of all packages.
+	Pkgs          []DepGraphPkg      `json:"pkgs"`          // The packages of the graph, including the root package.
+	Graph         DepGraphGraph      `json:"graph"`         // The nodes and edges of the graph.
+}
+
+type DepGraphPkgManager struct {
+	Name         string               `json:"name"`                   // The name of the package manager, e.g. `gomodules` or `npm`.
+	Repositories []DepGraphRepository `json:"repositories,omitempty"` // The repositories the packages are resolved from.
+	Version      string               `json:"version,omitempty"`      // The version of the package manager.
+}
+
+type DepGraphRepository struct {
+	Alias string `json:"alias"` // The alias of the repository, e.g. `alpine:3.20`.
+}
+
+type DepGraphPkg struct {
+	ID   string          `json:"id"`   // The package identifier, i.e. `name@version`.
+	Info DepGraphPkgInfo `json:"info"` // The package name and version.
+}
+
+type DepGraphPkgInfo struct {
+	Name    string `json:"name"`              // The name of the package.
+	Version string `json:"version,omitempty"` // The version of the package.
+	PURL    string `json:"purl,omitempty"`    // The package URL of the package.
+}
+
+type DepGraphGraph struct {
+	RootNodeID string         `json:"rootNodeId"` // The ID of the node of the root package.
+	Nodes      []DepGraphNode `json:"nodes"`      // The nodes of the graph, each node references a package.
+}
+
+type DepGraphNode struct {
+	NodeID string            `json:"nodeId"`         // The node identifier, unique in the graph.
+	PkgID  string            `json:"pkgId"`          // The package of the node, see DepGraphPkg.ID.
+	Deps   []DepGraphNodeRef `json:"deps"`           // The direct dependencies of the node.
+	Info   *DepGraphNodeInfo `json:"info,omitempty"` // The additional node information.
+}
+
+type DepGraphNodeRef struct {
+	NodeID string `json:"nodeId"` // The ID of the referenced node.
+}
+
+type DepGraphNodeInfo struct {
+	Labels map[string]string `json:"labels,omitempty"` // The labels of the node, e.g. `scope: dev`.
+}
+
+// Validate checks that package ids are `name@version` and unique, node ids are unique, all
+// referenced nodes and packages exist and the graph is acyclic.
+func (d *DepGraph) Validate() error {
+	pkgs := make(map[string]bool, len(d.Pkgs))
+	for _, pkg := range d.Pkgs {
+		if pkgID := pkg.Info.Name + "@" + pkg.Info.Version; pkg.ID != pkgID {
+			return fmt.Errorf("%w %q: must be %q", ErrDepGraphInvalidPkgID, pkg.ID, pkgID)
+		}
+		if pkgs[pkg.ID] {
+			return fmt.Errorf("%w %q", ErrDepGraphDuplicatePkg, pkg.ID)
+		}
+		pkgs[pkg.ID] = true
+	}
+
+	nodes := make(map[string]*DepGraphNode, len(d.Graph.Nodes))
+	for i, node := range d.Graph.Nodes {
+		if _, ok := nodes[node.NodeID]; ok {
+			return fmt.Errorf("%w %q", ErrDepGraphDuplicateNode, node.NodeID)
+		}
+		if !pkgs[node.PkgID] {
+			return fmt.Errorf("%w: %q of node %q", ErrDepGraphUnknownPkg, node.PkgID, node.NodeID)
+		}
+		nodes[node.NodeID] = &d.Graph.Nodes[i]
+	}
+	if _, ok := nodes[d.Graph.RootNodeID]; !ok {
+		return fmt.Errorf("%w: root node %q", ErrDepGraphUnknownNode, d.Graph.RootNodeID)
+	}
+	for _, node := range d.Graph.Nodes {
+		for _, dep := range node.Deps {
+			if _, ok := nodes[dep.NodeID]; !ok {
+				return fmt.Errorf("%w: %q is a dependency of node %q", ErrDepGraphUnknownNode, dep.NodeID, node.NodeID)
+			}
+		}
+	}
+
+	// depth-first search over all nodes, a node in state visiting reached again closes a cycle
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(nodes))
+	var path []string
+	var visit func(nodeID string) error
+	visit = func(nodeID string) error {
+		switch state[nodeID] {
+		case visiting:
+			start := slices.Index(path, nodeID)
+			return fmt.Errorf("%w: %v", ErrDepGraphCycle, append(slices.Clone(path[start:]), nodeID))
+		case visited:
+			return nil
+		}
+		state[nodeID] = visiting
+		path = append(path, nodeID)
+		for _, dep := range nodes[nodeID].Deps {
+			if err := visit(dep.NodeID); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		state[nodeID] = visited
+		return nil
+	}
+	for _, node := range d.Graph.Nodes {
+		if err := visit(node.NodeID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DepGraphBuilder constructs a DepGraph, which is validated on Build. The first error encountered
+// is reported by Build, so calls can be chained. The depgraph package wraps this builder to
+// provide a read-only graph with canonical serialisation. For example:
+//
+//	depGraph, err := snyk.NewDepGraphBuilder("gomodules", snyk.DepGraphPkgInfo{Name: "app", Version: "1.0.0"}).
+//		AddPkgNode("a", snyk.DepGraphPkgInfo{Name: "a", Version: "1.2.3"}).
+//		ConnectDep(snyk.DepGraphRootNodeID, "a").
+//		Build()
+type DepGraphBuilder struct {
+	depGraph *DepGraph
+	pkgs     map[string]DepGraphPkgInfo
+	nodes    map[string]int // index of the node in depGraph.Graph.Nodes
+	err      error
+}
+
+// NewDepGraphBuilder creates a DepGraphBuilder for the package manager. The root package is
+// added with the node ID DepGraphRootNodeID.
+func NewDepGraphBuilder(pkgManager string, rootPkg DepGraphPkgInfo) *DepGraphBuilder {
+	b := &DepGraphBuilder{
+		depGraph: &DepGraph{
+			SchemaVersion: depGraphSchemaVersion,
+			PkgManager:    DepGraphPkgManager{Name: pkgManager},
+			Graph:         DepGraphGraph{RootNodeID: DepGraphRootNodeID},
+		},
+		pkgs:  make(map[string]DepGraphPkgInfo),
+		nodes: make(map[string]int),
+	}
+	return b.AddPkgNode(DepGraphRootNodeID, rootPkg)
+}
+
+// AddPkgNode adds a node for the package. The package itself is added only once, even if it
+// is referenced by several nodes, but all nodes must use the same details for it.
+func (b *DepGraphBuilder) AddPkgNode(nodeID string, pkg DepGraphPkgInfo) *DepGraphBuilder {
+	if b.err != nil {
+		return b
+	}
+	if nodeID == "" {
+		b.err = errors.New("failed to add dep-graph node: node id must be supplied")
+		return b
+	}
+	if pkg.Name == "" {
+		b.err = fmt.Errorf("failed to add dep-graph node %q: package name must be supplied", nodeID)
+		return b
+	}
+	if _, ok := b.nodes[nodeID]; ok {
+		b.err = fmt.Errorf("failed to add dep-graph node: %w %q", ErrDepGraphDuplicateNode, nodeID)
+		return b
+	}
+
+	pkgID := pkg.Name + "@" + pkg.Version
+	if existing, ok := b.pkgs[pkgID]; !ok {
+		b.pkgs[pkgID] = pkg
+		b.depGraph.Pkgs = append(b.depGraph.Pkgs, DepGraphPkg{ID: pkgID, Info: pkg})
+	} else if existing != pkg {
+		b.err = fmt.Errorf("failed to add dep-graph node %q: %w %q with different details", nodeID, ErrDepGraphDuplicatePkg, pkgID)
+		return b
+	}
+	b.nodes[nodeID] = len(b.depGraph.Graph.Nodes)
+	b.depGraph.Graph.Nodes = append(b.depGraph.Graph.Nodes, DepGraphNode{NodeID: nodeID, PkgID: pkgID, Deps: []DepGraphNodeRef{}})
+
+	return b
+}
+
+// ConnectDep adds an edge from the parent node to the dependency node. Both nodes must be added before.
+func (b *DepGraphBuilder) ConnectDep(parentNodeID, depNodeID string) *DepGraphBuilder {
+	if b.err != nil {
+		return b
+	}
+	parent, ok := b.nodes[parentNodeID]
+	if !ok {
+		b.err = fmt.Errorf("failed to connect dep-graph nodes: %w %q", ErrDepGraphUnknownNode, parentNodeID)
+		return b
+	}
+	if _, ok := b.nodes[depNodeID]; !ok {
+		b.err = fmt.Errorf("failed to connect dep-graph nodes: %w %q", ErrDepGraphUnknownNode, depNodeID)
+		return b
+	}
+
+	node := &b.depGraph.Graph.Nodes[parent]
+	if !slices.Contains(node.Deps, DepGraphNodeRef{NodeID: depNodeID}) {
+		node.Deps = append(node.Deps, DepGraphNodeRef{NodeID: depNodeID})
+	}
+
+	return b
+}
+
+// SetLabel sets a label of the node, e.g. `scope: dev`.
+func (b *DepGraphBuilder) SetLabel(nodeID, key, value string) *DepGraphBuilder {
+	if b.err != nil {
+		return b
+	}
+	i, ok := b.nodes[nodeID]
+	if !ok {
+		b.err = fmt.Errorf("failed to set dep-graph label: %w %q", ErrDepGraphUnknownNode, nodeID)
+		return b
+	}
+
+	node := &b.depGraph.Graph.Nodes[i]
+	if node.Info == nil {
+		node.Info = &DepGraphNodeInfo{}
+	}
+	if node.Info.Labels == nil {
+		node.Info.Labels = make(map[string]string)
+	}
+	node.Info.Labels[key] = value
+
+	return b
+}
+
+// Build validates and returns the DepGraph, or the first error which occurred while building it.
+// The DepGraphBuilder must not be used after Build.
+func (b *DepGraphBuilder) Build() (*DepGraph, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	if err := b.depGraph.Validate(); err != nil {
+		return nil, err
+	}
+	return b.depGraph, nil
+}
+
+// DepGraphTestResult represents the result of testing a dependency graph.
+type DepGraphTestResult struct {
+	AffectedPkgs map[string]TestAffectedPkg `json:"affectedPkgs"`          // The vulnerable packages by package ID.
+	IssuesData   map[string]TestIssueData   `json:"issuesData"`            // The details of the found issues by issue ID.
+	Meta         *TestMeta                  `json:"-"`                     // The information about the test run.
+	Remediation  *TestRemediation           `json:"remediation,omitempty"` // The remediation advice for the found issues.
+}
+
+type TestAffectedPkg struct {
+	Pkg    DepGraphPkgInfo              `json:"pkg"`    // The vulnerable package.
+	Issues map[string]TestAffectedIssue `json:"issues"` // The issues of the package by issue ID.
+}
+
+type TestAffectedIssue struct {
+	IssueID string       `json:"issueId"`           // The issue identifier, see DepGraphTestResult.IssuesData.
+	FixInfo *TestFixInfo `json:"fixInfo,omitempty"` // The fix information of the issue for the package.
+}
+
+type TestFixInfo struct {
+	IsPatchable           bool              `json:"isPatchable"`                     // Whether a patch is available.
+	NearestFixedInVersion string            `json:"nearestFixedInVersion,omitempty"` // The nearest version of the package without the issue.
+	UpgradePaths          []TestUpgradePath `json:"upgradePaths,omitempty"`          // The upgrades of direct dependencies fixing the issue.
+}
+
+type TestUpgradePath struct {
+	Path []TestUpgradePathPkg `json:"path"`
+}
+
+type TestUpgradePathPkg struct {
+	Name       string `json:"name"`
+	Version    string `json:"version"`
+	NewVersion string `json:"newVersion,omitempty"` // The version to upgrade to, empty if no upgrade is needed.
+}
+
+// TestIssueData represents a vulnerability or license issue found by a test.
+type TestIssueData struct {
+	ID              string              `json:"id"`                        // The issue identifier.
+	Type            string              `json:"type,omitempty"`            // The issue type, `vuln` or `license`.
+	Title           string              `json:"title"`                     // The title of the issue.
+	Severity        string              `json:"severity"`                  // The severity of the issue.
+	CVSSScore       float64             `json:"cvssScore,omitempty"`       // The CVSS score of the issue.
+	CVSSv3          string              `json:"CVSSv3,omitempty"`          // The CVSS v3 vector of the issue.
+	Description     string              `json:"description,omitempty"`     // The description of the issue in markdown.
+	DisclosureTime  *time.Time          `json:"disclosureTime,omitempty"`  // The time the issue was disclosed.
+	ExploitMaturity string              `json:"exploitMaturity,omitempty"` // The exploit maturity of the issue.
+	FixedIn         []string            `json:"fixedIn,omitempty"`         // The versions of the package without the issue.
+	Identifiers     map[string][]string `json:"identifiers,omitempty"`     // The external identifiers of the issue, e.g. CVE or CWE.
+	Language        string              `json:"language,omitempty"`        // The language of the package.
+	PackageManager  string              `json:"packageManager,omitempty"`  // The package manager of the package.
+	PackageName     string              `json:"packageName,omitempty"`     // The name of the vulnerable package.
+	PublicationTime *time.Time          `json:"publicationTime,omitempty"` // The time the issue was published by Snyk.
+	References      []TestIssueRef      `json:"references,omitempty"`      // The references about the issue.
+	Semver          *TestIssueSemver    `json:"semver,omitempty"`          // The vulnerable version ranges.
+}
+
+type TestIssueRef struct {
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+type TestIssueSemver struct {
+	Vulnerable []string `json:"vulnerable"` // The vulnerable version ranges.
+}
+
+type TestRemediation struct {
+	Pin        map[string]TestRemediationPin     `json:"pin,omitempty"`        // The pins of transitive dependencies by package ID.
+	Unresolved []TestUnresolvedIssue             `json:"unresolved,omitempty"` // The issues without remediation.
+	Upgrade    map[string]TestRemediationUpgrade `json:"upgrade,omitempty"`    // The upgrades of direct dependencies by package ID.
+}
+
+type TestRemediationPin struct {
+	IsTransitive bool     `json:"isTransitive"` // Whether the package is a transitive dependency.
+	UpgradeTo    string   `json:"upgradeTo"`    // The package ID to pin to.
+	Vulns        []string `json:"vulns"`        // The IDs of the fixed issues.
+}
+
+type TestRemediationUpgrade struct {
+	UpgradeTo string   `json:"upgradeTo"`          // The package ID to upgrade to.
+	Upgrades  []string `json:"upgrades,omitempty"` // The package IDs of the upgraded transitive dependencies.
+	Vulns     []string `json:"vulns"`              // The IDs of the fixed issues.
+}
+
+type TestUnresolvedIssue struct {
+	TestIssueData
+
+	From         []string `json:"from,omitempty"` // The dependency path to the vulnerable package.
+	IsPatchable  bool     `json:"isPatchable"`
+	IsUpgradable bool     `json:"isUpgradable"`
+	Name         string   `json:"name"`    // The name of the vulnerable package.
+	Version      string   `json:"version"` // The version of the vulnerable package.
+}
+
+type TestMeta struct {
+	IsLicensesEnabled bool         `json:"isLicensesEnabled"` // Whether license issues are tested.
+	IsPrivate         bool         `json:"isPrivate"`         // Whether the test counts as private test.
+	Org               *TestMetaOrg `json:"org,omitempty"`     // The Organization the test was run in.
+}
+
+type TestMetaOrg struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// TestIssue represents an issue of a single affected package.
+type TestIssue struct {
+	PkgID   string          // The package ID of the affected package.
+	Pkg     DepGraphPkgInfo // The affected package.
+	FixInfo *TestFixInfo    // The fix information of the issue for the package.
+	Data    TestIssueData   // The details of the issue.
+}
+
+// Issues flattens AffectedPkgs and IssuesData into a list of issues per affected package,
+// sorted by package ID and issue ID.
+func (r *DepGraphTestResult) Issues() []TestIssue {
+	var issues []TestIssue
+	for pkgID, affectedPkg := range r.AffectedPkgs {
+		for issueID, affectedIssue := range affectedPkg.Issues {
+			issue := TestIssue{PkgID: pkgID, Pkg: affectedPkg.Pkg, FixInfo: affectedIssue.FixInfo}
+			if data, ok := r.IssuesData[issueID]; ok {
+				issue.Data = data
+			} else {
+				issue.Data = TestIssueData{ID: issueID}
+			}
+			issues = append(issues, issue)
+		}
+	}
+	slices.SortFunc(issues, func(a, b TestIssue) int {
+		return cmp.Or(cmp.Compare(a.PkgID, b.PkgID), cmp.Compare(a.Data.ID, b.Data.ID))
+	})
+	return issues
+}
+
+// DepGraphMonitorMeta describes the project created by MonitorDepGraph.
+type DepGraphMonitorMeta struct {
+	ProjectName string `json:"projectName,omitempty"` // The name of the project, the root package name if not set.
+	TargetFile  string `json:"targetFile,omitempty"`  // The manifest file the dependency graph was resolved from.
+}
+
+// DepGraphMonitorResult represents the project monitored by MonitorDepGraph.
+type DepGraphMonitorResult struct {
+	ID           string `json:"id"`                    // The ID of the monitored project.
+	IsMonitored  bool   `json:"isMonitored"`           // Whether the project is monitored.
+	OK           bool   `json:"ok"`                    // Whether the snapshot was taken.
+	Org          string `json:"org,omitempty"`         // The name of the Organization of the project.
+	ProjectName  string `json:"projectName,omitempty"` // The name of the project.
+	TrialStarted bool   `json:"trialStarted"`          // Whether a trial was started by monitoring the project.
+	URI          string `json:"uri"`                   // The URL of the project in the Snyk UI.
+}
+
+type testOptions struct {
+	OrgID string `url:"org,omitempty"`
+}
+
+type depGraphTestRoot struct {
+	Result *DepGraphTestResult `json:"result"`
+	Meta   *TestMeta           `json:"meta,omitempty"`
+}
+
+func (dg DepGraph) String() string { return Stringify(dg) }
+
+func (r DepGraphTestResult) String() string { return Stringify(r) }
+
+func (r DepGraphMonitorResult) String() string { return Stringify(r) }
+
+func (s *TestService) TestDepGraph(ctx context.Context, orgID string, depGraph *DepGraph) (*DepGraphTestResult, *Response, error) {
+	if depGraph == nil {
+		return nil, nil, errors.New("failed to test dep-graph: dep-graph must be supplied")
+	}
+
+	path, err := addOptions(fmt.Sprintf("%v/dep-graph", testV1BasePath), &testOptions{OrgID: orgID})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body := struct {
+		DepGraph *DepGraph `json:"depGraph"`
+	}{DepGraph: depGraph}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodPost, s.client.v1BaseURL, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(depGraphTestRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if root.Result == nil {
+		root.Result = &DepGraphTestResult{}
+	}
+	root.Result.Meta = root.Meta
+
+	return root.Result, resp, nil
+}
+
+func (s *TestService) MonitorDepGraph(ctx context.Context, orgID string, depGraph *DepGraph, meta *DepGraphMonitorMeta) (*DepGraphMonitorResult, *Response, error) {
+	if depGraph == nil {
+		return nil, nil, errors.New("failed to monitor dep-graph: dep-graph must be supplied")
+	}
+
+	path, err := addOptions(fmt.Sprintf("%v/dep-graph", monitorV1BasePath), &testOptions{OrgID: orgID})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body := struct {
+		DepGraph *DepGraph            `json:"depGraph"`
+		Meta     *DepGraphMonitorMeta `json:"meta,omitempty"`
+	}{DepGraph: depGraph, Meta: meta}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodPost, s.client.v1BaseURL, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(DepGraphMonitorResult)
+	resp, err := s.client.do(ctx, req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, nil
+}
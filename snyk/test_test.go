@@ -0,0 +1,240 @@
+package snyk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDepGraphBuilder_Build(t *testing.T) {
+	depGraph, err := NewDepGraphBuilder("gomodules", DepGraphPkgInfo{Name: "app", Version: "1.0.0"}).
+		AddPkgNode("a", DepGraphPkgInfo{Name: "a", Version: "1.2.3"}).
+		AddPkgNode("b", DepGraphPkgInfo{Name: "b", Version: "0.1.0"}).
+		AddPkgNode("b-2", DepGraphPkgInfo{Name: "b", Version: "0.1.0"}).
+		ConnectDep(DepGraphRootNodeID, "a").
+		ConnectDep(DepGraphRootNodeID, "b").
+		ConnectDep("a", "b-2").
+		ConnectDep("a", "b-2").
+		Build()
+
+	assert.NoError(t, err)
+	expectedDepGraph := &DepGraph{
+		SchemaVersion: "1.2.0",
+		PkgManager:    DepGraphPkgManager{Name: "gomodules"},
+		Pkgs: []DepGraphPkg{
+			{ID: "app@1.0.0", Info: DepGraphPkgInfo{Name: "app", Version: "1.0.0"}},
+			{ID: "a@1.2.3", Info: DepGraphPkgInfo{Name: "a", Version: "1.2.3"}},
+			{ID: "b@0.1.0", Info: DepGraphPkgInfo{Name: "b", Version: "0.1.0"}},
+		},
+		Graph: DepGraphGraph{
+			RootNodeID: "root-node",
+			Nodes: []DepGraphNode{
+				{NodeID: "root-node", PkgID: "app@1.0.0", Deps: []DepGraphNodeRef{{NodeID: "a"}, {NodeID: "b"}}},
+				{NodeID: "a", PkgID: "a@1.2.3", Deps: []DepGraphNodeRef{{NodeID: "b-2"}}},
+				{NodeID: "b", PkgID: "b@0.1.0", Deps: []DepGraphNodeRef{}},
+				{NodeID: "b-2", PkgID: "b@0.1.0", Deps: []DepGraphNodeRef{}},
+			},
+		},
+	}
+	assert.Equal(t, expectedDepGraph, depGraph)
+}
+
+func TestDepGraphBuilder_Build_errors(t *testing.T) {
+	tests := map[string]struct {
+		builder     *DepGraphBuilder
+		expectedErr string
+	}{
+		"duplicate node": {
+			builder: NewDepGraphBuilder("npm", DepGraphPkgInfo{Name: "app"}).
+				AddPkgNode("a", DepGraphPkgInfo{Name: "a"}).
+				AddPkgNode("a", DepGraphPkgInfo{Name: "a"}),
+			expectedErr: `duplicate node "a"`,
+		},
+		"duplicate package": {
+			builder: NewDepGraphBuilder("npm", DepGraphPkgInfo{Name: "app"}).
+				AddPkgNode("a", DepGraphPkgInfo{Name: "a", Version: "1.0.0", PURL: "pkg:npm/a@1.0.0"}).
+				AddPkgNode("b", DepGraphPkgInfo{Name: "a", Version: "1.0.0", PURL: "pkg:npm/b@1.0.0"}),
+			expectedErr: `duplicate package "a@1.0.0" with different details`,
+		},
+		"unknown dependency": {
+			builder: NewDepGraphBuilder("npm", DepGraphPkgInfo{Name: "app"}).
+				ConnectDep(DepGraphRootNodeID, "missing"),
+			expectedErr: `unknown node "missing"`,
+		},
+		"cycle": {
+			builder: NewDepGraphBuilder("npm", DepGraphPkgInfo{Name: "app"}).
+				AddPkgNode("a", DepGraphPkgInfo{Name: "a"}).
+				AddPkgNode("b", DepGraphPkgInfo{Name: "b"}).
+				ConnectDep(DepGraphRootNodeID, "a").
+				ConnectDep("a", "b").
+				ConnectDep("b", "a"),
+			expectedErr: "dependency cycle: [a b a]",
+		},
+		"missing package name": {
+			builder:     NewDepGraphBuilder("npm", DepGraphPkgInfo{}),
+			expectedErr: "package name must be supplied",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.builder.Build()
+
+			assert.ErrorContains(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestDepGraphBuilder_SetLabel(t *testing.T) {
+	depGraph, err := NewDepGraphBuilder("npm", DepGraphPkgInfo{Name: "app"}).
+		AddPkgNode("a", DepGraphPkgInfo{Name: "a"}).
+		SetLabel("a", "scope", "dev").
+		Build()
+
+	assert.NoError(t, err)
+	assert.Equal(t, &DepGraphNodeInfo{Labels: map[string]string{"scope": "dev"}}, depGraph.Graph.Nodes[1].Info)
+}
+
+func TestDepGraph_Validate(t *testing.T) {
+	tests := map[string]struct {
+		depGraph    *DepGraph
+		expectedErr error
+	}{
+		"invalid package id": {
+			depGraph: &DepGraph{
+				Pkgs:  []DepGraphPkg{{ID: "x", Info: DepGraphPkgInfo{Name: "a", Version: "1"}}},
+				Graph: DepGraphGraph{RootNodeID: "root", Nodes: []DepGraphNode{{NodeID: "root", PkgID: "x"}}},
+			},
+			expectedErr: ErrDepGraphInvalidPkgID,
+		},
+		"unknown package": {
+			depGraph: &DepGraph{
+				Graph: DepGraphGraph{RootNodeID: "root", Nodes: []DepGraphNode{{NodeID: "root", PkgID: "a@1"}}},
+			},
+			expectedErr: ErrDepGraphUnknownPkg,
+		},
+		"missing root node": {
+			depGraph:    &DepGraph{Graph: DepGraphGraph{RootNodeID: "root"}},
+			expectedErr: ErrDepGraphUnknownNode,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.ErrorIs(t, test.depGraph.Validate(), test.expectedErr)
+		})
+	}
+}
+
+func TestTest_TestDepGraph(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/test/dep-graph", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "org-id", r.URL.Query().Get("org"))
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Contains(t, body, "depGraph")
+		_, _ = fmt.Fprint(w, `
+{
+  "result": {
+    "affectedPkgs": {
+      "minimatch@2.0.0": {
+        "pkg": { "name": "minimatch", "version": "2.0.0" },
+        "issues": {
+          "SNYK-JS-MINIMATCH-10105": {
+            "issueId": "SNYK-JS-MINIMATCH-10105",
+            "fixInfo": { "isPatchable": false, "nearestFixedInVersion": "3.0.2" }
+          }
+        }
+      }
+    },
+    "issuesData": {
+      "SNYK-JS-MINIMATCH-10105": {
+        "id": "SNYK-JS-MINIMATCH-10105",
+        "title": "Regular Expression Denial of Service (ReDoS)",
+        "severity": "high",
+        "cvssScore": 7.5,
+        "fixedIn": [ "3.0.2" ],
+        "identifiers": { "CVE": [ "CVE-2016-10540" ] },
+        "publicationTime": "2016-06-20T16:00:06Z"
+      }
+    },
+    "remediation": {
+      "unresolved": [],
+      "upgrade": {
+        "minimatch@2.0.0": { "upgradeTo": "minimatch@3.0.2", "vulns": [ "SNYK-JS-MINIMATCH-10105" ] }
+      }
+    }
+  },
+  "meta": { "isPrivate": true, "isLicensesEnabled": false, "org": { "id": "org-id", "name": "my-org" } }
+}
+`)
+	})
+	depGraph, err := NewDepGraphBuilder("npm", DepGraphPkgInfo{Name: "app", Version: "1.0.0"}).
+		AddPkgNode("minimatch", DepGraphPkgInfo{Name: "minimatch", Version: "2.0.0"}).
+		ConnectDep(DepGraphRootNodeID, "minimatch").
+		Build()
+	assert.NoError(t, err)
+
+	result, _, err := client.Test.TestDepGraph(ctx, "org-id", depGraph)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "my-org", result.Meta.Org.Name)
+	assert.Equal(t, "minimatch@3.0.2", result.Remediation.Upgrade["minimatch@2.0.0"].UpgradeTo)
+	issues := result.Issues()
+	if assert.Len(t, issues, 1) {
+		assert.Equal(t, "minimatch@2.0.0", issues[0].PkgID)
+		assert.Equal(t, "high", issues[0].Data.Severity)
+		assert.Equal(t, "3.0.2", issues[0].FixInfo.NearestFixedInVersion)
+	}
+}
+
+func TestTest_TestDepGraph_emptyDepGraph(t *testing.T) {
+	_, _, err := client.Test.TestDepGraph(ctx, "org-id", nil)
+
+	assert.ErrorContains(t, err, "dep-graph must be supplied")
+}
+
+func TestTest_MonitorDepGraph(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/monitor/dep-graph", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Empty(t, r.URL.Query().Get("org"))
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]any{"projectName": "my-project"}, body["meta"])
+		_, _ = fmt.Fprint(w, `
+{
+  "ok": true,
+  "org": "my-org",
+  "id": "project-id",
+  "isMonitored": true,
+  "trialStarted": false,
+  "uri": "https://app.snyk.io/org/my-org/project/project-id"
+}
+`)
+	})
+	depGraph, err := NewDepGraphBuilder("npm", DepGraphPkgInfo{Name: "app", Version: "1.0.0"}).Build()
+	assert.NoError(t, err)
+	expectedResult := &DepGraphMonitorResult{
+		ID:          "project-id",
+		IsMonitored: true,
+		OK:          true,
+		Org:         "my-org",
+		URI:         "https://app.snyk.io/org/my-org/project/project-id",
+	}
+
+	actualResult, _, err := client.Test.MonitorDepGraph(ctx, "", depGraph, &DepGraphMonitorMeta{ProjectName: "my-project"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedResult, actualResult)
+}
@@ -25,3 +25,17 @@ func (kvm *KeyValueMap) MarshalJSON() ([]byte, error) {
 	}
 	return json.Marshal(*kvm)
 }
+
+// Relationship represents a jsonapi relationship to another resource.
+//
+// See: https://jsonapi.org/format/#document-resource-object-relationships
+type Relationship struct {
+	Data  *ResourceIdentifier `json:"data,omitempty"`
+	Links *PaginatedLinks     `json:"links,omitempty"`
+}
+
+// ResourceIdentifier identifies a single resource by its type and ID.
+type ResourceIdentifier struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
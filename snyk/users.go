@@ -2,7 +2,11 @@ package snyk
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"iter"
 	"net/http"
+	"time"
 )
 
 const (
@@ -17,6 +21,45 @@ type UsersServiceAPI interface {
 	//
 	// See: https://docs.snyk.io/snyk-api/reference/users#get-self
 	GetSelf(ctx context.Context) (*User, *Response, error)
+
+	// GetOrgUser provides the details about a user who is a member of an Organization.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/users#get-orgs-org_id-users-id
+	GetOrgUser(ctx context.Context, orgID, userID string) (*User, *Response, error)
+
+	// GetGroupUser provides the details about a user who is a member of a Group.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/users#get-groups-group_id-users-id
+	GetGroupUser(ctx context.Context, groupID, userID string) (*User, *Response, error)
+
+	// RemoveGroupUser removes a user from a Group, including the memberships in all Organizations of the Group.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/users#patch-groups-group_id-users-id
+	RemoveGroupUser(ctx context.Context, groupID, userID string) (*Response, error)
+
+	// ListGroupMemberships gets a paginated list of the user memberships of a Group. The memberships
+	// can be filtered, e.g. by the email of the user, with ListUserMembershipsOptions.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/groups#get-groups-group_id-memberships
+	ListGroupMemberships(ctx context.Context, groupID string, opts *ListUserMembershipsOptions) ([]UserMembership, *Response, error)
+
+	// AllGroupMemberships returns an iterator to paginate over all user memberships of a Group.
+	//
+	// This method handles the pagination logic internally by calling ListGroupMemberships for each page.
+	// The return iterated can be used in a for...range loop to easily process all memberships.
+	//
+	// Note: This function is experimental and its signature may change in a future release.
+	AllGroupMemberships(ctx context.Context, groupID string, opts *ListUserMembershipsOptions) (iter.Seq2[UserMembership, *Response], func() error)
+
+	// UpdateGroupMembership changes the role of a user membership in a Group.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/groups#patch-groups-group_id-memberships-membership_id
+	UpdateGroupMembership(ctx context.Context, groupID, membershipID string, updateRequest *UserMembershipUpdateRequest) (*Response, error)
+
+	// UpdateOrgMembership changes the role of a user membership in an Organization.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/orgs#patch-orgs-org_id-memberships-membership_id
+	UpdateOrgMembership(ctx context.Context, orgID, membershipID string, updateRequest *UserMembershipUpdateRequest) (*Response, error)
 }
 
 // UsersService handles communication with the user related methods of the Snyk API.
@@ -34,18 +77,75 @@ type User struct {
 }
 
 type UserAttributes struct {
-	DefaultOrgID string `json:"default_org_context,omitempty"` // The ID of the default Organization for the User.
-	Email        string `json:"email,omitempty"`               // The email of the User.
-	Name         string `json:"name"`                          // The name of the User.
-	Username     string `json:"username,omitempty"`            // The username of the User.
+	Active       *bool                     `json:"active,omitempty"`              // Whether the User is active, only available in an Organization or Group.
+	DefaultOrgID string                    `json:"default_org_context,omitempty"` // The ID of the default Organization for the User.
+	Email        string                    `json:"email,omitempty"`               // The email of the User.
+	Membership   *UserMembershipAttributes `json:"membership,omitempty"`          // The membership of the User, only available in an Organization or Group.
+	Name         string                    `json:"name"`                          // The name of the User.
+	Username     string                    `json:"username,omitempty"`            // The username of the User.
+}
+
+// UserMembership represents the membership of a user in an Organization or Group.
+type UserMembership struct {
+	ID            string                       `json:"id"`                      // The UserMembership identifier.
+	Type          string                       `json:"type"`                    // The resource type, e.g. `group_membership`.
+	Attributes    *UserMembershipAttributes    `json:"attributes,omitempty"`    // The UserMembership resource data.
+	Relationships *UserMembershipRelationships `json:"relationships,omitempty"` // The relationships object describing the member and the role.
+}
+
+type UserMembershipAttributes struct {
+	CreatedAt time.Time `json:"created_at,omitempty"` // The time the membership was created.
+	Role      string    `json:"role,omitempty"`       // The name of the role, only set if the membership is embedded in a User.
+}
+
+type UserMembershipRelationships struct {
+	Group *groupRoot          `json:"group,omitempty"`
+	Role  *membershipRoleRoot `json:"role,omitempty"`
+	User  *userRoot           `json:"user,omitempty"`
+}
+
+// MembershipRole represents the role granted by a UserMembership.
+type MembershipRole struct {
+	ID         string                    `json:"id"`                   // The MembershipRole identifier.
+	Type       string                    `json:"type"`                 // The resource type, e.g. `group_role`.
+	Attributes *MembershipRoleAttributes `json:"attributes,omitempty"` // The MembershipRole resource data.
+}
+
+type MembershipRoleAttributes struct {
+	Name string `json:"name"` // The display name of the role.
+}
+
+type ListUserMembershipsOptions struct {
+	ListOptions
+	Email     string `url:"email,omitempty"`      // If set, only return memberships of the user with this email.
+	RoleName  string `url:"role_name,omitempty"`  // If set, only return memberships with this role.
+	SortBy    string `url:"sort_by,omitempty"`    // The field to sort by, e.g. 'username' or 'email'.
+	SortOrder string `url:"sort_order,omitempty"` // The sort order, 'ASC' or 'DESC'.
+	UserID    string `url:"user_id,omitempty"`    // If set, only return memberships of the user with this ID.
+	Username  string `url:"username,omitempty"`   // If set, only return memberships of the user with this username.
+}
+
+type UserMembershipUpdateRequest struct {
+	RoleID string // The ID of the new role.
 }
 
 type userRoot struct {
 	User *User `json:"data,omitempty"`
 }
 
+type userMembershipsRoot struct {
+	Memberships []UserMembership `json:"data"`
+	Links       *PaginatedLinks  `json:"links,omitempty"`
+}
+
+type membershipRoleRoot struct {
+	Role *MembershipRole `json:"data,omitempty"`
+}
+
 func (u User) String() string { return Stringify(u) }
 
+func (um UserMembership) String() string { return Stringify(um) }
+
 func (s *UsersService) GetSelf(ctx context.Context) (*User, *Response, error) {
 	opts := &BaseOptions{Version: usersAPIVersion}
 
@@ -67,3 +167,192 @@ func (s *UsersService) GetSelf(ctx context.Context) (*User, *Response, error) {
 
 	return root.User, resp, nil
 }
+
+func (s *UsersService) GetOrgUser(ctx context.Context, orgID, userID string) (*User, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to get org user: org id must be supplied")
+	}
+	if userID == "" {
+		return nil, nil, errors.New("failed to get org user: user id must be supplied")
+	}
+
+	return s.getUser(ctx, fmt.Sprintf("%v/%v/users/%v", orgsBasePath, orgID, userID))
+}
+
+func (s *UsersService) GetGroupUser(ctx context.Context, groupID, userID string) (*User, *Response, error) {
+	if groupID == "" {
+		return nil, nil, errors.New("failed to get group user: group id must be supplied")
+	}
+	if userID == "" {
+		return nil, nil, errors.New("failed to get group user: user id must be supplied")
+	}
+
+	return s.getUser(ctx, fmt.Sprintf("%v/%v/users/%v", groupsBasePath, groupID, userID))
+}
+
+func (s *UsersService) RemoveGroupUser(ctx context.Context, groupID, userID string) (*Response, error) {
+	if groupID == "" {
+		return nil, errors.New("failed to remove group user: group id must be supplied")
+	}
+	if userID == "" {
+		return nil, errors.New("failed to remove group user: user id must be supplied")
+	}
+
+	opts := &BaseOptions{Version: usersAPIVersion}
+
+	path, err := addOptions(fmt.Sprintf("%v/%v/users/%v", groupsBasePath, groupID, userID), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// inline jsonapi update payload to keep update function simple, a null membership removes the user
+	var updateRequestJSON struct {
+		Data struct {
+			Attributes struct {
+				Membership *UserMembershipAttributes `json:"membership"`
+			} `json:"attributes"`
+			ID   string `json:"id"`
+			Type string `json:"type"`
+		} `json:"data"`
+	}
+	updateRequestJSON.Data.ID = userID
+	updateRequestJSON.Data.Type = "user"
+
+	req, err := s.client.prepareRequest(ctx, http.MethodPatch, s.client.restBaseURL, path, updateRequestJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
+
+func (s *UsersService) ListGroupMemberships(ctx context.Context, groupID string, opts *ListUserMembershipsOptions) ([]UserMembership, *Response, error) {
+	if groupID == "" {
+		return nil, nil, errors.New("failed to list group memberships: group id must be supplied")
+	}
+
+	if opts == nil {
+		opts = &ListUserMembershipsOptions{}
+	}
+	if opts.Version == "" {
+		opts.Version = usersAPIVersion
+	}
+
+	path, err := addOptions(fmt.Sprintf("%v/%v/memberships", groupsBasePath, groupID), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, s.client.restBaseURL, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(userMembershipsRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if l := root.Links; l != nil {
+		resp.Links = l
+	}
+
+	return root.Memberships, resp, nil
+}
+
+func (s *UsersService) AllGroupMemberships(ctx context.Context, groupID string, opts *ListUserMembershipsOptions) (iter.Seq2[UserMembership, *Response], func() error) {
+	if groupID == "" {
+		return errorPaginator[UserMembership](errors.New("failed to list group memberships: group id must be supplied"))
+	}
+
+	if opts == nil {
+		opts = &ListUserMembershipsOptions{}
+	}
+	if opts.Version == "" {
+		opts.Version = usersAPIVersion
+	}
+	return newPaginator[UserMembership](ctx, s.client, s.client.restBaseURL, fmt.Sprintf("%v/%v/memberships", groupsBasePath, groupID), opts)
+}
+
+func (s *UsersService) UpdateGroupMembership(ctx context.Context, groupID, membershipID string, updateRequest *UserMembershipUpdateRequest) (*Response, error) {
+	if groupID == "" {
+		return nil, errors.New("failed to update group membership: group id must be supplied")
+	}
+	if membershipID == "" {
+		return nil, errors.New("failed to update group membership: membership id must be supplied")
+	}
+	if updateRequest == nil || updateRequest.RoleID == "" {
+		return nil, errors.New("failed to update group membership: role id must be supplied")
+	}
+
+	return s.updateMembership(ctx, fmt.Sprintf("%v/%v/memberships/%v", groupsBasePath, groupID, membershipID), membershipID, "group", updateRequest.RoleID)
+}
+
+func (s *UsersService) UpdateOrgMembership(ctx context.Context, orgID, membershipID string, updateRequest *UserMembershipUpdateRequest) (*Response, error) {
+	if orgID == "" {
+		return nil, errors.New("failed to update org membership: org id must be supplied")
+	}
+	if membershipID == "" {
+		return nil, errors.New("failed to update org membership: membership id must be supplied")
+	}
+	if updateRequest == nil || updateRequest.RoleID == "" {
+		return nil, errors.New("failed to update org membership: role id must be supplied")
+	}
+
+	return s.updateMembership(ctx, fmt.Sprintf("%v/%v/memberships/%v", orgsBasePath, orgID, membershipID), membershipID, "org", updateRequest.RoleID)
+}
+
+func (s *UsersService) getUser(ctx context.Context, endpointURL string) (*User, *Response, error) {
+	opts := &BaseOptions{Version: usersAPIVersion}
+
+	path, err := addOptions(endpointURL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, s.client.restBaseURL, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(userRoot)
+	resp, err := s.client.do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.User, resp, nil
+}
+
+// updateMembership assigns a new role to the membership, scope is either 'org' or 'group'.
+func (s *UsersService) updateMembership(ctx context.Context, endpointURL, membershipID, scope, roleID string) (*Response, error) {
+	opts := &BaseOptions{Version: usersAPIVersion}
+
+	path, err := addOptions(endpointURL, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// inline jsonapi update payload to keep update function simple
+	var updateRequestJSON struct {
+		Data struct {
+			ID            string `json:"id"`
+			Relationships struct {
+				Role struct {
+					Data ResourceIdentifier `json:"data"`
+				} `json:"role"`
+			} `json:"relationships"`
+			Type string `json:"type"`
+		} `json:"data"`
+	}
+	updateRequestJSON.Data.ID = membershipID
+	updateRequestJSON.Data.Relationships.Role.Data = ResourceIdentifier{ID: roleID, Type: scope + "_role"}
+	updateRequestJSON.Data.Type = scope + "_membership"
+
+	req, err := s.client.prepareRequest(ctx, http.MethodPatch, s.client.restBaseURL, path, updateRequestJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
@@ -1,5 +1,15 @@
 package snyk
 
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
 //
 //import (
 //	"fmt"
@@ -62,3 +72,164 @@ package snyk
 //	assert.NoError(t, err)
 //	assert.Equal(t, expectedUser, actualUser)
 //}
+
+func TestUsers_GetGroupUser(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/groups/group-id/users/user-id", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		_, _ = fmt.Fprint(w, `
+{
+  "data": {
+    "id": "user-id",
+    "type": "user",
+    "attributes": {
+      "active": true,
+      "email": "test-user@snyk.io",
+      "membership": { "created_at": "2024-01-02T03:04:05Z", "role": "Group Admin" },
+      "name": "Test User",
+      "username": "test-user"
+    }
+  }
+}
+`)
+	})
+	active := true
+	expectedUser := &User{
+		ID:   "user-id",
+		Type: "user",
+		Attributes: &UserAttributes{
+			Active: &active,
+			Email:  "test-user@snyk.io",
+			Membership: &UserMembershipAttributes{
+				CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+				Role:      "Group Admin",
+			},
+			Name:     "Test User",
+			Username: "test-user",
+		},
+	}
+
+	actualUser, _, err := client.Users.GetGroupUser(ctx, "group-id", "user-id")
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedUser, actualUser)
+}
+
+func TestUsers_GetOrgUser(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/users/user-id", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		_, _ = fmt.Fprint(w, `{ "data": { "id": "user-id", "type": "user", "attributes": { "name": "Test User" } } }`)
+	})
+
+	actualUser, _, err := client.Users.GetOrgUser(ctx, "org-id", "user-id")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Test User", actualUser.Attributes.Name)
+}
+
+func TestUsers_GetOrgUser_emptyUserID(t *testing.T) {
+	_, _, err := client.Users.GetOrgUser(ctx, "org-id", "")
+
+	assert.ErrorContains(t, err, "user id must be supplied")
+}
+
+func TestUsers_RemoveGroupUser(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/groups/group-id/users/user-id", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		expectedBody := map[string]any{
+			"data": map[string]any{
+				"id":         "user-id",
+				"type":       "user",
+				"attributes": map[string]any{"membership": nil},
+			},
+		}
+		assert.Equal(t, expectedBody, body)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Users.RemoveGroupUser(ctx, "group-id", "user-id")
+
+	assert.NoError(t, err)
+}
+
+func TestUsers_AllGroupMemberships(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/groups/group-id/memberships", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "test-user@snyk.io", r.URL.Query().Get("email"))
+		if r.URL.Query().Get("starting_after") == "" {
+			_, _ = fmt.Fprint(w, `{
+  "data": [
+    {
+      "id": "membership-1",
+      "type": "group_membership",
+      "relationships": {
+        "role": { "data": { "id": "role-id", "type": "group_role", "attributes": { "name": "Group Member" } } },
+        "user": { "data": { "id": "user-id", "type": "user", "attributes": { "email": "test-user@snyk.io", "name": "Test User" } } }
+      }
+    }
+  ],
+  "links": { "next": "/groups/group-id/memberships?starting_after=cursor-1" }
+}`)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{ "data": [ { "id": "membership-2", "type": "group_membership" } ], "links": {} }`)
+	})
+
+	var membershipIDs []string
+	memberships, errFn := client.Users.AllGroupMemberships(ctx, "group-id", &ListUserMembershipsOptions{Email: "test-user@snyk.io"})
+	for membership := range memberships {
+		membershipIDs = append(membershipIDs, membership.ID)
+		if membership.ID == "membership-1" {
+			assert.Equal(t, "Group Member", membership.Relationships.Role.Role.Attributes.Name)
+			assert.Equal(t, "test-user@snyk.io", membership.Relationships.User.User.Attributes.Email)
+		}
+	}
+
+	assert.NoError(t, errFn())
+	assert.Equal(t, []string{"membership-1", "membership-2"}, membershipIDs)
+}
+
+func TestUsers_UpdateOrgMembership(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/orgs/org-id/memberships/membership-id", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		expectedBody := map[string]any{
+			"data": map[string]any{
+				"id":   "membership-id",
+				"type": "org_membership",
+				"relationships": map[string]any{
+					"role": map[string]any{"data": map[string]any{"id": "role-id", "type": "org_role"}},
+				},
+			},
+		}
+		assert.Equal(t, expectedBody, body)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Users.UpdateOrgMembership(ctx, "org-id", "membership-id", &UserMembershipUpdateRequest{RoleID: "role-id"})
+
+	assert.NoError(t, err)
+}
+
+func TestUsers_UpdateGroupMembership_emptyRoleID(t *testing.T) {
+	_, err := client.Users.UpdateGroupMembership(ctx, "group-id", "membership-id", &UserMembershipUpdateRequest{})
+
+	assert.ErrorContains(t, err, "role id must be supplied")
+}
@@ -0,0 +1,274 @@
+/*
+Package webhook provides an http.Handler receiving Snyk webhook events.
+
+The Handler verifies the signature of every delivery, rejects oversized bodies and replayed
+deliveries, decodes the payload into the typed events of the snyk package and dispatches
+them to the registered callbacks:
+
+	handler, err := webhook.NewHandler(secret)
+	if err != nil {
+		return err
+	}
+	handler.OnProjectSnapshot(func(ctx context.Context, event *snyk.ProjectSnapshotEvent) error {
+		log.Printf("project %s has %d new issues", event.Project.Name, len(event.NewIssues))
+		return nil
+	})
+	http.Handle("/snyk/webhook", handler)
+
+Replay protection requires the X-Snyk-Timestamp and X-Snyk-Transport-Id headers, deliveries
+without them are rejected unless the Handler is created with WithoutReplayProtection. These
+headers are not covered by the signature, so on their own they only catch accidental
+re-deliveries. A captured delivery resent with fresh headers is detected by its payload, which
+is signed: a payload is handled only once within the replay window, no matter which transport
+id it is delivered with.
+*/
+package webhook
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pavel-snyk/snyk-sdk-go/v2/snyk"
+)
+
+const (
+	defaultMaxBodySize  = 5 << 20 // 5 MiB
+	defaultReplayWindow = 5 * time.Minute
+)
+
+// Handler is an http.Handler receiving Snyk webhook events.
+type Handler struct {
+	secret       string
+	maxBodySize  int64
+	replayWindow time.Duration
+	// replayProtection enables the timestamp check and the deduplication by transport id and payload.
+	replayProtection bool
+	now              func() time.Time
+
+	mu                sync.Mutex // guards the callbacks and deliveries.
+	onPing            func(ctx context.Context, event *snyk.PingEvent) error
+	onProjectSnapshot func(ctx context.Context, event *snyk.ProjectSnapshotEvent) error
+	deliveries        map[string]time.Time // replay keys of reserved deliveries to the time they were reserved.
+}
+
+var _ http.Handler = (*Handler)(nil)
+
+type HandlerOption func(*Handler) error
+
+// WithMaxBodySize configures Handler to reject payloads larger than maxBodySize bytes.
+func WithMaxBodySize(maxBodySize int64) HandlerOption {
+	return func(handler *Handler) error {
+		if maxBodySize <= 0 {
+			return fmt.Errorf("invalid max body size (%d): must be positive", maxBodySize)
+		}
+		handler.maxBodySize = maxBodySize
+		return nil
+	}
+}
+
+// WithReplayWindow configures Handler to reject deliveries sent longer than replayWindow ago
+// and deliveries already handled within replayWindow.
+func WithReplayWindow(replayWindow time.Duration) HandlerOption {
+	return func(handler *Handler) error {
+		if replayWindow <= 0 {
+			return fmt.Errorf("invalid replay window (%v): must be positive", replayWindow)
+		}
+		handler.replayWindow = replayWindow
+		return nil
+	}
+}
+
+// WithoutReplayProtection configures Handler to accept deliveries without timestamp or transport id
+// and to skip the replay checks entirely. Use it only if replays are handled by the callbacks.
+func WithoutReplayProtection() HandlerOption {
+	return func(handler *Handler) error {
+		handler.replayProtection = false
+		return nil
+	}
+}
+
+// NewHandler creates a new Handler verifying deliveries with the secret used to create the webhook.
+func NewHandler(secret string, opts ...HandlerOption) (*Handler, error) {
+	if secret == "" {
+		return nil, errors.New("failed to create webhook handler: secret must be supplied")
+	}
+
+	h := &Handler{
+		secret:           secret,
+		maxBodySize:      defaultMaxBodySize,
+		replayWindow:     defaultReplayWindow,
+		replayProtection: true,
+		now:              time.Now,
+		deliveries:       make(map[string]time.Time),
+	}
+
+	for _, opt := range opts {
+		if err := opt(h); err != nil {
+			return nil, err
+		}
+	}
+
+	return h, nil
+}
+
+// OnPing registers the callback for ping events. A callback error results in a 500 response,
+// which makes Snyk retry the delivery.
+func (h *Handler) OnPing(fn func(ctx context.Context, event *snyk.PingEvent) error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.onPing = fn
+}
+
+// OnProjectSnapshot registers the callback for project snapshot events. A callback error results
+// in a 500 response, which makes Snyk retry the delivery.
+func (h *Handler) OnProjectSnapshot(fn func(ctx context.Context, event *snyk.ProjectSnapshotEvent) error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.onProjectSnapshot = fn
+}
+
+// ServeHTTP handles a single webhook delivery. Events without a registered callback and events
+// of unknown types are acknowledged without further processing.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, h.maxBodySize))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			http.Error(w, "payload too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, "failed to read payload", http.StatusBadRequest)
+		return
+	}
+
+	if err := snyk.VerifyWebhookSignature(h.secret, body, r.Header.Get(snyk.WebhookSignatureHeader)); err != nil {
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	var replayKeys []string
+	if h.replayProtection {
+		if err := h.checkTimestamp(r.Header.Get(snyk.WebhookTimestampHeader)); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		transportID := r.Header.Get(snyk.WebhookTransportIDHeader)
+		if transportID == "" {
+			http.Error(w, "missing transport id", http.StatusBadRequest)
+			return
+		}
+		payloadHash := sha256.Sum256(body)
+		replayKeys = []string{"transport:" + transportID, "payload:" + hex.EncodeToString(payloadHash[:])}
+		if !h.reserve(replayKeys...) {
+			http.Error(w, "delivery already handled", http.StatusConflict)
+			return
+		}
+	}
+
+	eventType := r.Header.Get(snyk.WebhookEventHeader)
+	switch snyk.WebhookEventType(eventType) {
+	case snyk.WebhookEventTypePing, snyk.WebhookEventTypeProjectSnapshot:
+	default:
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	event, err := snyk.ParseWebhookEvent(eventType, body)
+	if err != nil {
+		h.release(replayKeys...)
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.dispatch(r.Context(), event); err != nil {
+		h.release(replayKeys...)
+		http.Error(w, "failed to handle event", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) dispatch(ctx context.Context, event any) error {
+	h.mu.Lock()
+	onPing, onProjectSnapshot := h.onPing, h.onProjectSnapshot
+	h.mu.Unlock()
+
+	switch e := event.(type) {
+	case *snyk.PingEvent:
+		if onPing != nil {
+			return onPing(ctx, e)
+		}
+	case *snyk.ProjectSnapshotEvent:
+		if onProjectSnapshot != nil {
+			return onProjectSnapshot(ctx, e)
+		}
+	}
+	return nil
+}
+
+// checkTimestamp rejects deliveries without timestamp or sent outside the replay window.
+func (h *Handler) checkTimestamp(timestamp string) error {
+	if timestamp == "" {
+		return errors.New("missing timestamp")
+	}
+
+	sentAt, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return fmt.Errorf("invalid timestamp %q", timestamp)
+	}
+	if age := h.now().Sub(sentAt); age > h.replayWindow || age < -h.replayWindow {
+		return fmt.Errorf("timestamp %q outside of replay window", timestamp)
+	}
+	return nil
+}
+
+// reserve records a delivery identified by its replay keys as being handled. It reports false
+// if any of the keys was already reserved within the replay window, so concurrent duplicates and
+// payloads resent with another transport id are dispatched only once.
+func (h *Handler) reserve(keys ...string) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := h.now()
+	for key, reservedAt := range h.deliveries {
+		if now.Sub(reservedAt) > h.replayWindow {
+			delete(h.deliveries, key)
+		}
+	}
+	for _, key := range keys {
+		if _, reserved := h.deliveries[key]; reserved {
+			return false
+		}
+	}
+	for _, key := range keys {
+		h.deliveries[key] = now
+	}
+	return true
+}
+
+// release removes the reservation of a delivery that failed, so a retry is handled again.
+func (h *Handler) release(keys ...string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, key := range keys {
+		delete(h.deliveries, key)
+	}
+}
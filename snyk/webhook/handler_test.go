@@ -0,0 +1,318 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pavel-snyk/snyk-sdk-go/v2/snyk"
+)
+
+const testSecret = "top-secret"
+
+var testNow = time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func newTestHandler(t *testing.T, opts ...HandlerOption) *Handler {
+	t.Helper()
+
+	handler, err := NewHandler(testSecret, opts...)
+	assert.NoError(t, err)
+	handler.now = func() time.Time { return testNow }
+	return handler
+}
+
+func newDelivery(eventType, body string) *http.Request {
+	mac := hmac.New(sha256.New, []byte(testSecret))
+	mac.Write([]byte(body))
+
+	r := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body))
+	r.Header.Set(snyk.WebhookEventHeader, eventType)
+	r.Header.Set(snyk.WebhookSignatureHeader, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	r.Header.Set(snyk.WebhookTimestampHeader, testNow.Format(time.RFC3339))
+	r.Header.Set(snyk.WebhookTransportIDHeader, "transport-id")
+	return r
+}
+
+func TestNewHandler_emptySecret(t *testing.T) {
+	_, err := NewHandler("")
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "secret must be supplied")
+}
+
+func TestHandler_ServeHTTP_projectSnapshot(t *testing.T) {
+	handler := newTestHandler(t)
+	var received *snyk.ProjectSnapshotEvent
+	handler.OnProjectSnapshot(func(_ context.Context, event *snyk.ProjectSnapshotEvent) error {
+		received = event
+		return nil
+	})
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, newDelivery("project_snapshot/v0", `{"project":{"id":"project-id","name":"goof"},"newIssues":[{"id":"SNYK-1"}],"removedIssues":[]}`))
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	if assert.NotNil(t, received) {
+		assert.Equal(t, "project-id", received.Project.ID)
+		assert.Len(t, received.NewIssues, 1)
+	}
+}
+
+func TestHandler_ServeHTTP_ping(t *testing.T) {
+	handler := newTestHandler(t)
+	var received *snyk.PingEvent
+	handler.OnPing(func(_ context.Context, event *snyk.PingEvent) error {
+		received = event
+		return nil
+	})
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, newDelivery("ping", `{"webhookPublicId":"webhook-id"}`))
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, &snyk.PingEvent{WebhookPublicID: "webhook-id"}, received)
+}
+
+func TestHandler_ServeHTTP_rejected(t *testing.T) {
+	tests := map[string]struct {
+		opts         []HandlerOption
+		request      func() *http.Request
+		expectedCode int
+	}{
+		"wrong method": {
+			request: func() *http.Request {
+				return httptest.NewRequest(http.MethodGet, "/webhook", nil)
+			},
+			expectedCode: http.StatusMethodNotAllowed,
+		},
+		"invalid signature": {
+			request: func() *http.Request {
+				r := newDelivery("ping", `{"webhookPublicId":"webhook-id"}`)
+				r.Header.Set(snyk.WebhookSignatureHeader, "sha256=00")
+				return r
+			},
+			expectedCode: http.StatusUnauthorized,
+		},
+		"missing signature": {
+			request: func() *http.Request {
+				r := newDelivery("ping", `{"webhookPublicId":"webhook-id"}`)
+				r.Header.Del(snyk.WebhookSignatureHeader)
+				return r
+			},
+			expectedCode: http.StatusUnauthorized,
+		},
+		"oversized body": {
+			opts: []HandlerOption{WithMaxBodySize(8)},
+			request: func() *http.Request {
+				return newDelivery("ping", `{"webhookPublicId":"webhook-id"}`)
+			},
+			expectedCode: http.StatusRequestEntityTooLarge,
+		},
+		"stale timestamp": {
+			request: func() *http.Request {
+				r := newDelivery("ping", `{"webhookPublicId":"webhook-id"}`)
+				r.Header.Set(snyk.WebhookTimestampHeader, testNow.Add(-time.Hour).Format(time.RFC3339))
+				return r
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		"missing timestamp": {
+			request: func() *http.Request {
+				r := newDelivery("ping", `{"webhookPublicId":"webhook-id"}`)
+				r.Header.Del(snyk.WebhookTimestampHeader)
+				return r
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		"missing transport id": {
+			request: func() *http.Request {
+				r := newDelivery("ping", `{"webhookPublicId":"webhook-id"}`)
+				r.Header.Del(snyk.WebhookTransportIDHeader)
+				return r
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		"invalid payload": {
+			request: func() *http.Request {
+				return newDelivery("project_snapshot/v0", `{"project":`)
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			handler := newTestHandler(t, tc.opts...)
+			called := false
+			handler.OnPing(func(context.Context, *snyk.PingEvent) error {
+				called = true
+				return nil
+			})
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, tc.request())
+
+			assert.Equal(t, tc.expectedCode, w.Code)
+			assert.False(t, called)
+		})
+	}
+}
+
+func TestHandler_ServeHTTP_replay(t *testing.T) {
+	handler := newTestHandler(t)
+	calls := 0
+	handler.OnPing(func(context.Context, *snyk.PingEvent) error {
+		calls++
+		return nil
+	})
+
+	first := httptest.NewRecorder()
+	handler.ServeHTTP(first, newDelivery("ping", `{"webhookPublicId":"webhook-id"}`))
+	replayed := httptest.NewRecorder()
+	handler.ServeHTTP(replayed, newDelivery("ping", `{"webhookPublicId":"webhook-id"}`))
+
+	assert.Equal(t, http.StatusNoContent, first.Code)
+	assert.Equal(t, http.StatusConflict, replayed.Code)
+	assert.Equal(t, 1, calls)
+}
+
+func TestHandler_ServeHTTP_replayWithFreshHeaders(t *testing.T) {
+	handler := newTestHandler(t)
+	calls := 0
+	handler.OnPing(func(context.Context, *snyk.PingEvent) error {
+		calls++
+		return nil
+	})
+
+	first := httptest.NewRecorder()
+	handler.ServeHTTP(first, newDelivery("ping", `{"webhookPublicId":"webhook-id"}`))
+	replay := newDelivery("ping", `{"webhookPublicId":"webhook-id"}`)
+	replay.Header.Set(snyk.WebhookTransportIDHeader, "forged-transport-id")
+	replay.Header.Set(snyk.WebhookTimestampHeader, testNow.Add(time.Minute).Format(time.RFC3339))
+	replayed := httptest.NewRecorder()
+	handler.ServeHTTP(replayed, replay)
+	other := newDelivery("ping", `{"webhookPublicId":"other-webhook-id"}`)
+	other.Header.Set(snyk.WebhookTransportIDHeader, "other-transport-id")
+	differentPayload := httptest.NewRecorder()
+	handler.ServeHTTP(differentPayload, other)
+
+	assert.Equal(t, http.StatusNoContent, first.Code)
+	assert.Equal(t, http.StatusConflict, replayed.Code)
+	assert.Equal(t, http.StatusNoContent, differentPayload.Code)
+	assert.Equal(t, 2, calls)
+}
+
+func TestHandler_ServeHTTP_concurrentReplay(t *testing.T) {
+	handler := newTestHandler(t)
+	var calls int
+	var mu sync.Mutex
+	entered := make(chan struct{})
+	proceed := make(chan struct{})
+	handler.OnPing(func(context.Context, *snyk.PingEvent) error {
+		mu.Lock()
+		calls++
+		mu.Unlock()
+		close(entered)
+		<-proceed
+		return nil
+	})
+
+	first := httptest.NewRecorder()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		handler.ServeHTTP(first, newDelivery("ping", `{"webhookPublicId":"webhook-id"}`))
+	}()
+	<-entered
+	duplicate := httptest.NewRecorder()
+	handler.ServeHTTP(duplicate, newDelivery("ping", `{"webhookPublicId":"webhook-id"}`))
+	close(proceed)
+	<-done
+
+	assert.Equal(t, http.StatusNoContent, first.Code)
+	assert.Equal(t, http.StatusConflict, duplicate.Code)
+	assert.Equal(t, 1, calls)
+}
+
+func TestHandler_ServeHTTP_withoutReplayProtection(t *testing.T) {
+	handler := newTestHandler(t, WithoutReplayProtection())
+	calls := 0
+	handler.OnPing(func(context.Context, *snyk.PingEvent) error {
+		calls++
+		return nil
+	})
+
+	for range 2 {
+		r := newDelivery("ping", `{"webhookPublicId":"webhook-id"}`)
+		r.Header.Del(snyk.WebhookTimestampHeader)
+		r.Header.Del(snyk.WebhookTransportIDHeader)
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, r)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	}
+	assert.Equal(t, 2, calls)
+}
+
+func TestHandler_ServeHTTP_callbackErrorAllowsRetry(t *testing.T) {
+	handler := newTestHandler(t)
+	calls := 0
+	handler.OnPing(func(context.Context, *snyk.PingEvent) error {
+		calls++
+		if calls == 1 {
+			return errors.New("temporary failure")
+		}
+		return nil
+	})
+
+	failed := httptest.NewRecorder()
+	handler.ServeHTTP(failed, newDelivery("ping", `{"webhookPublicId":"webhook-id"}`))
+	retried := httptest.NewRecorder()
+	handler.ServeHTTP(retried, newDelivery("ping", `{"webhookPublicId":"webhook-id"}`))
+
+	assert.Equal(t, http.StatusInternalServerError, failed.Code)
+	assert.Equal(t, http.StatusNoContent, retried.Code)
+	assert.Equal(t, 2, calls)
+}
+
+func TestHandler_ServeHTTP_unknownEventType(t *testing.T) {
+	handler := newTestHandler(t)
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, newDelivery("project_snapshot/v99", `{}`))
+
+	assert.Equal(t, http.StatusAccepted, w.Code)
+}
+
+func TestHandler_withServer(t *testing.T) {
+	handler := newTestHandler(t)
+	received := make(chan string, 1)
+	handler.OnPing(func(_ context.Context, event *snyk.PingEvent) error {
+		received <- event.WebhookPublicID
+		return nil
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	r := newDelivery("ping", `{"webhookPublicId":"webhook-id"}`)
+	req, err := http.NewRequest(http.MethodPost, server.URL, r.Body)
+	assert.NoError(t, err)
+	req.Header = r.Header
+	resp, err := server.Client().Do(req)
+	assert.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, "webhook-id", <-received)
+}
@@ -0,0 +1,337 @@
+package snyk
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const webhooksV1BasePath = orgV1BasePath + "/%v/webhooks"
+
+const (
+	WebhookEventHeader       = "X-Snyk-Event"        // The header with the event type, e.g. 'project_snapshot/v0'.
+	WebhookSignatureHeader   = "X-Hub-Signature"     // The header with the HMAC signature of the payload.
+	WebhookTimestampHeader   = "X-Snyk-Timestamp"    // The header with the time the event was sent.
+	WebhookTransportIDHeader = "X-Snyk-Transport-Id" // The header with the unique identifier of the delivery.
+)
+
+// WebhookEventType defines the type of event delivered to a webhook.
+type WebhookEventType string
+
+const (
+	WebhookEventTypePing            WebhookEventType = "ping"
+	WebhookEventTypeProjectSnapshot WebhookEventType = "project_snapshot/v0"
+)
+
+// ErrWebhookSignatureMismatch is returned by VerifyWebhookSignature if the payload is not signed with the secret.
+var ErrWebhookSignatureMismatch = errors.New("webhook signature mismatch")
+
+// WebhooksServiceAPI is an interface for interacting with the webhooks endpoints of the Snyk V1 API.
+//
+// See: https://docs.snyk.io/snyk-api/reference/webhooks-v1
+type WebhooksServiceAPI interface {
+	// List provides all webhooks of an organization.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/webhooks-v1#get-org-orgid-webhooks
+	List(ctx context.Context, orgID string) ([]Webhook, *Response, error)
+
+	// Get provides the details of a webhook.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/webhooks-v1#get-org-orgid-webhooks-webhookid
+	Get(ctx context.Context, orgID, webhookID string) (*Webhook, *Response, error)
+
+	// Create makes a new webhook. The secret is used to sign the payloads, see VerifyWebhookSignature.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/webhooks-v1#post-org-orgid-webhooks
+	Create(ctx context.Context, orgID string, createRequest *WebhookCreateRequest) (*Webhook, *Response, error)
+
+	// Delete removes a webhook.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/webhooks-v1#delete-org-orgid-webhooks-webhookid
+	Delete(ctx context.Context, orgID, webhookID string) (*Response, error)
+
+	// Ping sends a ping event to a webhook.
+	//
+	// See: https://docs.snyk.io/snyk-api/reference/webhooks-v1#post-org-orgid-webhooks-webhookid-ping
+	Ping(ctx context.Context, orgID, webhookID string) (*Response, error)
+}
+
+// WebhooksService handles communication with the webhook related methods of the Snyk V1 API.
+type WebhooksService service
+
+var _ WebhooksServiceAPI = (*WebhooksService)(nil)
+
+// Webhook represents a webhook of an organization.
+//
+// See: https://docs.snyk.io/snyk-api/how-to-use-snyk-webhooks-to-connect-snyk-to-slack-with-aws-lambda
+type Webhook struct {
+	ID  string `json:"id"`  // The Webhook identifier.
+	URL string `json:"url"` // The URL the events are delivered to.
+}
+
+type WebhookCreateRequest struct {
+	URL    string `json:"url"`    // The https URL to deliver the events to.
+	Secret string `json:"secret"` // The secret to sign the payloads with.
+}
+
+type webhooksRoot struct {
+	Webhooks []Webhook `json:"results"`
+	Total    int       `json:"total"`
+}
+
+func (w Webhook) String() string { return Stringify(w) }
+
+func (s *WebhooksService) List(ctx context.Context, orgID string) ([]Webhook, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to list webhooks: org id must be supplied")
+	}
+
+	path := fmt.Sprintf(webhooksV1BasePath, orgID)
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, s.client.v1BaseURL, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(webhooksRoot)
+	resp, err := s.client.do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Webhooks, resp, nil
+}
+
+func (s *WebhooksService) Get(ctx context.Context, orgID, webhookID string) (*Webhook, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to get webhook: org id must be supplied")
+	}
+	if webhookID == "" {
+		return nil, nil, errors.New("failed to get webhook: id must be supplied")
+	}
+
+	path := fmt.Sprintf(webhooksV1BasePath+"/%v", orgID, webhookID)
+
+	req, err := s.client.prepareRequest(ctx, http.MethodGet, s.client.v1BaseURL, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	webhook := new(Webhook)
+	resp, err := s.client.do(ctx, req, webhook)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return webhook, resp, nil
+}
+
+func (s *WebhooksService) Create(ctx context.Context, orgID string, createRequest *WebhookCreateRequest) (*Webhook, *Response, error) {
+	if orgID == "" {
+		return nil, nil, errors.New("failed to create webhook: org id must be supplied")
+	}
+	if createRequest == nil {
+		return nil, nil, errors.New("failed to create webhook: payload must be supplied")
+	}
+
+	path := fmt.Sprintf(webhooksV1BasePath, orgID)
+
+	req, err := s.client.prepareRequest(ctx, http.MethodPost, s.client.v1BaseURL, path, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	webhook := new(Webhook)
+	resp, err := s.client.do(ctx, req, webhook)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return webhook, resp, nil
+}
+
+func (s *WebhooksService) Delete(ctx context.Context, orgID, webhookID string) (*Response, error) {
+	if orgID == "" {
+		return nil, errors.New("failed to delete webhook: org id must be supplied")
+	}
+	if webhookID == "" {
+		return nil, errors.New("failed to delete webhook: id must be supplied")
+	}
+
+	path := fmt.Sprintf(webhooksV1BasePath+"/%v", orgID, webhookID)
+
+	req, err := s.client.prepareRequest(ctx, http.MethodDelete, s.client.v1BaseURL, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
+
+func (s *WebhooksService) Ping(ctx context.Context, orgID, webhookID string) (*Response, error) {
+	if orgID == "" {
+		return nil, errors.New("failed to ping webhook: org id must be supplied")
+	}
+	if webhookID == "" {
+		return nil, errors.New("failed to ping webhook: id must be supplied")
+	}
+
+	path := fmt.Sprintf(webhooksV1BasePath+"/%v/ping", orgID, webhookID)
+
+	req, err := s.client.prepareRequest(ctx, http.MethodPost, s.client.v1BaseURL, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.do(ctx, req, nil)
+}
+
+// VerifyWebhookSignature checks that body is signed with secret. The header is the value of
+// the WebhookSignatureHeader, e.g. 'sha256=<hex encoded HMAC>'.
+func VerifyWebhookSignature(secret string, body []byte, header string) error {
+	if secret == "" {
+		return errors.New("failed to verify webhook signature: secret must be supplied")
+	}
+
+	encodedSignature, found := strings.CutPrefix(header, "sha256=")
+	if !found {
+		return fmt.Errorf("failed to verify webhook signature: unsupported signature format %q", header)
+	}
+	signature, err := hex.DecodeString(encodedSignature)
+	if err != nil {
+		return fmt.Errorf("failed to verify webhook signature: %w", err)
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return ErrWebhookSignatureMismatch
+	}
+	return nil
+}
+
+// ParseWebhookEvent decodes the payload of a webhook event into its typed struct, i.e.
+// *PingEvent or *ProjectSnapshotEvent. The eventType is the value of the WebhookEventHeader.
+func ParseWebhookEvent(eventType string, body []byte) (any, error) {
+	var event any
+	switch WebhookEventType(eventType) {
+	case WebhookEventTypePing:
+		event = new(PingEvent)
+	case WebhookEventTypeProjectSnapshot:
+		event = new(ProjectSnapshotEvent)
+	default:
+		return nil, fmt.Errorf("failed to parse webhook event: unknown event type %q", eventType)
+	}
+
+	if err := json.Unmarshal(body, event); err != nil {
+		return nil, fmt.Errorf("failed to parse webhook event: %w", err)
+	}
+	return event, nil
+}
+
+// PingEvent represents the payload of a webhook ping event.
+type PingEvent struct {
+	WebhookPublicID string `json:"webhookPublicId"` // The identifier of the pinged webhook.
+}
+
+// ProjectSnapshotEvent represents the payload sent every time a project is tested and a new snapshot is created.
+//
+// See: https://docs.snyk.io/snyk-api/how-to-use-snyk-webhooks-to-connect-snyk-to-slack-with-aws-lambda
+type ProjectSnapshotEvent struct {
+	Group         *WebhookGroup   `json:"group,omitempty"`   // The group of the organization.
+	NewIssues     []WebhookIssue  `json:"newIssues"`         // The issues introduced since the previous snapshot.
+	Org           *WebhookOrg     `json:"org,omitempty"`     // The organization of the project.
+	Project       *WebhookProject `json:"project,omitempty"` // The tested project.
+	RemovedIssues []WebhookIssue  `json:"removedIssues"`     // The issues removed since the previous snapshot.
+}
+
+type WebhookGroup struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type WebhookOrg struct {
+	Created time.Time `json:"created,omitempty"`
+	ID      string    `json:"id"`
+	Name    string    `json:"name"`
+	Slug    string    `json:"slug,omitempty"`
+	URL     string    `json:"url,omitempty"`
+}
+
+type WebhookProject struct {
+	Attributes            *WebhookProjectLabels `json:"attributes,omitempty"`            // The project attributes.
+	BrowseURL             string                `json:"browseUrl,omitempty"`             // The URL of the project in the Snyk UI.
+	Branch                string                `json:"branch,omitempty"`                // The monitored branch, if available.
+	Created               time.Time             `json:"created,omitempty"`               // The time the project was created.
+	ID                    string                `json:"id"`                              // The project identifier.
+	ImageID               string                `json:"imageId,omitempty"`               // For container projects, the image ID.
+	ImageTag              string                `json:"imageTag,omitempty"`              // For container projects, the image tag.
+	IsMonitored           bool                  `json:"isMonitored"`                     // Whether the project is actively monitored.
+	IssueCountsBySeverity *WebhookIssueCounts   `json:"issueCountsBySeverity,omitempty"` // The number of issues by severity.
+	LastTestedDate        time.Time             `json:"lastTestedDate,omitempty"`        // The time the project was last tested.
+	Name                  string                `json:"name"`                            // The project name.
+	Origin                string                `json:"origin,omitempty"`                // The origin of the project, e.g. 'github' or 'cli'.
+	ReadOnly              bool                  `json:"readOnly"`                        // Whether the project is read only.
+	RemoteRepoURL         string                `json:"remoteRepoUrl,omitempty"`         // The URL of the remote repository, if available.
+	TargetReference       string                `json:"targetReference,omitempty"`       // The target reference, e.g. a branch name.
+	TestFrequency         string                `json:"testFrequency,omitempty"`         // The test frequency, e.g. 'daily'.
+	TotalDependencies     int                   `json:"totalDependencies,omitempty"`     // The number of dependencies.
+	Type                  string                `json:"type,omitempty"`                  // The package manager, e.g. 'npm' or 'maven'.
+}
+
+type WebhookProjectLabels struct {
+	Criticality []string `json:"criticality,omitempty"`
+	Environment []string `json:"environment,omitempty"`
+	Lifecycle   []string `json:"lifecycle,omitempty"`
+}
+
+type WebhookIssueCounts struct {
+	Critical int `json:"critical"`
+	High     int `json:"high"`
+	Medium   int `json:"medium"`
+	Low      int `json:"low"`
+}
+
+type WebhookIssue struct {
+	FixInfo     *WebhookIssueFixInfo  `json:"fixInfo,omitempty"`     // How the issue can be fixed.
+	ID          string                `json:"id"`                    // The issue identifier, e.g. 'SNYK-JS-LODASH-567746'.
+	IsIgnored   bool                  `json:"isIgnored"`             // Whether the issue is ignored.
+	IsPatched   bool                  `json:"isPatched"`             // Whether the issue is patched.
+	IssueData   *WebhookIssueData     `json:"issueData,omitempty"`   // The details of the issue.
+	IssueType   string                `json:"issueType"`             // The issue type, e.g. 'vuln' or 'license'.
+	PkgName     string                `json:"pkgName"`               // The name of the affected package.
+	PkgVersions []string              `json:"pkgVersions,omitempty"` // The affected versions of the package.
+	Priority    *WebhookIssuePriority `json:"priority,omitempty"`    // The priority of the issue.
+}
+
+type WebhookIssueData struct {
+	CVSSScore   float64             `json:"cvssScore,omitempty"`
+	Identifiers map[string][]string `json:"identifiers,omitempty"` // The external identifiers, e.g. 'CVE' or 'CWE'.
+	ID          string              `json:"id"`
+	Severity    string              `json:"severity"`
+	Title       string              `json:"title"`
+	URL         string              `json:"url,omitempty"`
+}
+
+type WebhookIssueFixInfo struct {
+	FixedIn               []string `json:"fixedIn,omitempty"`
+	IsFixable             bool     `json:"isFixable"`
+	IsPartiallyFixable    bool     `json:"isPartiallyFixable"`
+	IsPatchable           bool     `json:"isPatchable"`
+	IsPinnable            bool     `json:"isPinnable"`
+	IsUpgradable          bool     `json:"isUpgradable"`
+	NearestFixedInVersion string   `json:"nearestFixedInVersion,omitempty"`
+}
+
+type WebhookIssuePriority struct {
+	Score int `json:"score"`
+}
+
+func (e ProjectSnapshotEvent) String() string { return Stringify(e) }
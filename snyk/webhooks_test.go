@@ -0,0 +1,238 @@
+package snyk
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhooks_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/org/org-id/webhooks", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		_, _ = fmt.Fprint(w, `{ "results": [ { "id": "webhook-id", "url": "https://example.com/webhook" } ], "total": 1 }`)
+	})
+	expectedWebhooks := []Webhook{{ID: "webhook-id", URL: "https://example.com/webhook"}}
+
+	actualWebhooks, _, err := client.Webhooks.List(ctx, "org-id")
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedWebhooks, actualWebhooks)
+}
+
+func TestWebhooks_List_emptyOrgID(t *testing.T) {
+	_, _, err := client.Webhooks.List(ctx, "")
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "org id must be supplied")
+}
+
+func TestWebhooks_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/org/org-id/webhooks", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]any{"url": "https://example.com/webhook", "secret": "top-secret"}, body)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `{ "id": "webhook-id", "url": "https://example.com/webhook" }`)
+	})
+
+	webhook, _, err := client.Webhooks.Create(ctx, "org-id", &WebhookCreateRequest{
+		URL:    "https://example.com/webhook",
+		Secret: "top-secret",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, &Webhook{ID: "webhook-id", URL: "https://example.com/webhook"}, webhook)
+}
+
+func TestWebhooks_Create_emptyPayload(t *testing.T) {
+	_, _, err := client.Webhooks.Create(ctx, "org-id", nil)
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "payload must be supplied")
+}
+
+func TestWebhooks_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/org/org-id/webhooks/webhook-id", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		_, _ = fmt.Fprint(w, `{ "id": "webhook-id", "url": "https://example.com/webhook" }`)
+	})
+
+	webhook, _, err := client.Webhooks.Get(ctx, "org-id", "webhook-id")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "https://example.com/webhook", webhook.URL)
+}
+
+func TestWebhooks_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/org/org-id/webhooks/webhook-id", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+	})
+
+	_, err := client.Webhooks.Delete(ctx, "org-id", "webhook-id")
+
+	assert.NoError(t, err)
+}
+
+func TestWebhooks_Ping(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/org/org-id/webhooks/webhook-id/ping", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+	})
+
+	_, err := client.Webhooks.Ping(ctx, "org-id", "webhook-id")
+
+	assert.NoError(t, err)
+}
+
+func TestWebhooks_Ping_emptyWebhookID(t *testing.T) {
+	_, err := client.Webhooks.Ping(ctx, "org-id", "")
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "id must be supplied")
+}
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{"webhookPublicId":"webhook-id"}`)
+	mac := hmac.New(sha256.New, []byte("top-secret"))
+	mac.Write(body)
+	validHeader := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	tests := map[string]struct {
+		secret      string
+		body        []byte
+		header      string
+		expectedErr string
+	}{
+		"valid signature": {
+			secret: "top-secret",
+			body:   body,
+			header: validHeader,
+		},
+		"wrong secret": {
+			secret:      "other-secret",
+			body:        body,
+			header:      validHeader,
+			expectedErr: ErrWebhookSignatureMismatch.Error(),
+		},
+		"tampered body": {
+			secret:      "top-secret",
+			body:        []byte(`{"webhookPublicId":"other-id"}`),
+			header:      validHeader,
+			expectedErr: ErrWebhookSignatureMismatch.Error(),
+		},
+		"missing prefix": {
+			secret:      "top-secret",
+			body:        body,
+			header:      hex.EncodeToString(mac.Sum(nil)),
+			expectedErr: "unsupported signature format",
+		},
+		"invalid hex": {
+			secret:      "top-secret",
+			body:        body,
+			header:      "sha256=xyz",
+			expectedErr: "invalid byte",
+		},
+		"empty secret": {
+			body:        body,
+			header:      validHeader,
+			expectedErr: "secret must be supplied",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := VerifyWebhookSignature(tc.secret, tc.body, tc.header)
+
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestParseWebhookEvent_projectSnapshot(t *testing.T) {
+	body := []byte(`
+{
+  "project": {
+    "id": "project-id",
+    "name": "snyk/goof:package.json",
+    "type": "npm",
+    "origin": "github",
+    "isMonitored": true,
+    "issueCountsBySeverity": { "critical": 0, "high": 1, "medium": 0, "low": 0 }
+  },
+  "org": { "id": "org-id", "name": "my-org" },
+  "group": { "id": "group-id", "name": "my-group" },
+  "newIssues": [
+    {
+      "id": "SNYK-JS-LODASH-567746",
+      "issueType": "vuln",
+      "pkgName": "lodash",
+      "pkgVersions": [ "4.17.15" ],
+      "issueData": {
+        "id": "SNYK-JS-LODASH-567746",
+        "title": "Prototype Pollution",
+        "severity": "high",
+        "identifiers": { "CVE": [ "CVE-2020-8203" ] }
+      },
+      "fixInfo": { "isUpgradable": true, "fixedIn": [ "4.17.16" ] }
+    }
+  ],
+  "removedIssues": []
+}
+`)
+
+	event, err := ParseWebhookEvent("project_snapshot/v0", body)
+
+	assert.NoError(t, err)
+	snapshot, ok := event.(*ProjectSnapshotEvent)
+	assert.True(t, ok)
+	assert.Equal(t, "project-id", snapshot.Project.ID)
+	assert.Equal(t, 1, snapshot.Project.IssueCountsBySeverity.High)
+	assert.Equal(t, "group-id", snapshot.Group.ID)
+	assert.Len(t, snapshot.NewIssues, 1)
+	assert.Equal(t, []string{"CVE-2020-8203"}, snapshot.NewIssues[0].IssueData.Identifiers["CVE"])
+	assert.Equal(t, []string{"4.17.16"}, snapshot.NewIssues[0].FixInfo.FixedIn)
+	assert.Empty(t, snapshot.RemovedIssues)
+}
+
+func TestParseWebhookEvent_ping(t *testing.T) {
+	event, err := ParseWebhookEvent("ping", []byte(`{"webhookPublicId":"webhook-id"}`))
+
+	assert.NoError(t, err)
+	assert.Equal(t, &PingEvent{WebhookPublicID: "webhook-id"}, event)
+}
+
+func TestParseWebhookEvent_unknownType(t *testing.T) {
+	_, err := ParseWebhookEvent("project_snapshot/v99", []byte(`{}`))
+
+	assert.Error(t, err)
+	assert.ErrorContains(t, err, "unknown event type")
+}